@@ -0,0 +1,45 @@
+// Package arg implements the typed argument matchers accepted by the expecter
+// methods of testify mocks generated with `typed-args: true`.
+//
+// With typed arguments, passing a value of the wrong type to an expecter is a
+// compile error instead of a failed expectation at runtime:
+//
+//	m.EXPECT().Get(arg.Eq("/path")).Return("foo", nil)
+//	m.EXPECT().Get(arg.Any[string]()).Return("", errNotFound)
+//	m.EXPECT().Get(arg.Match(func(path string) bool {
+//		return strings.HasPrefix(path, "/api/")
+//	})).Return("bar", nil)
+//
+// Arg is defined in this package, instead of in every generated file, so that
+// mocks generated into several files of the same package can share it.
+package arg
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+// Arg matches an argument of type T. The zero Arg matches a nil argument.
+type Arg[T any] struct {
+	matcher any
+}
+
+// Eq returns an Arg that matches arguments equal to v.
+func Eq[T any](v T) Arg[T] {
+	return Arg[T]{matcher: v}
+}
+
+// Any returns an Arg that matches any argument, like mock.Anything.
+func Any[T any]() Arg[T] {
+	return Arg[T]{matcher: mock.Anything}
+}
+
+// Match returns an Arg that matches the arguments for which fn returns true,
+// like mock.MatchedBy.
+func Match[T any](fn func(T) bool) Arg[T] {
+	return Arg[T]{matcher: mock.MatchedBy(fn)}
+}
+
+// Matcher returns the value passed to mock.Mock.On for the argument.
+func (a Arg[T]) Matcher() any {
+	return a.matcher
+}
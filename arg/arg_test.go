@@ -0,0 +1,24 @@
+package arg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMatchers(t *testing.T) {
+	args := mock.Arguments{
+		Eq("foo").Matcher(),
+		Any[int]().Matcher(),
+		Match(func(s string) bool { return len(s) == 3 }).Matcher(),
+		Arg[error]{}.Matcher(),
+	}
+
+	_, diffs := args.Diff([]any{"foo", 42, "bar", nil})
+	assert.Zero(t, diffs)
+	_, diffs = args.Diff([]any{"bar", 42, "bar", nil})
+	assert.Equal(t, 1, diffs)
+	_, diffs = args.Diff([]any{"foo", 42, "quux", nil})
+	assert.Equal(t, 1, diffs)
+}
@@ -0,0 +1,105 @@
+// Package await implements the call notifications behind the wait helpers of
+// testify mocks generated with `wait-helpers: true`.
+//
+// Code under test often calls its dependencies from other goroutines. Instead
+// of sleeping or polling until AssertExpectations passes, a test can wait for
+// the calls it expects:
+//
+//	go worker.Run(ctx)
+//	if !m.WaitForGet(time.Second) {
+//		t.Fatal("Get was not called")
+//	}
+//	if !m.WaitForExpectations(ctx) {
+//		m.AssertExpectations(t)
+//	}
+package await
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Calls counts the calls made to the methods of a mock, and wakes up the
+// goroutines waiting for them. It acts as a condition variable that can be
+// waited on with a context. The zero Calls is ready to use.
+type Calls struct {
+	mu      sync.Mutex
+	counts  map[string]int
+	changed chan struct{}
+}
+
+// Record records a call to method and wakes up the waiting goroutines.
+func (c *Calls) Record(method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = make(map[string]int)
+	}
+	c.counts[method]++
+	if c.changed != nil {
+		close(c.changed)
+		c.changed = nil
+	}
+}
+
+// Count returns the number of calls recorded for method.
+func (c *Calls) Count(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[method]
+}
+
+// Until waits until cond returns true, and reports whether it did before ctx
+// was done. cond is checked once, then again after every recorded call.
+func (c *Calls) Until(ctx context.Context, cond func() bool) bool {
+	for {
+		// Take the channel before checking cond, so that a call recorded
+		// in between isn't missed.
+		changed := c.next()
+		if cond() {
+			return true
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// next returns a channel that is closed by the next call to Record.
+func (c *Calls) next() <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.changed == nil {
+		c.changed = make(chan struct{})
+	}
+	return c.changed
+}
+
+// Called waits until method has been called at least once, and reports
+// whether it was before ctx was done.
+func (c *Calls) Called(ctx context.Context, method string) bool {
+	return c.Until(ctx, func() bool {
+		return c.Count(method) > 0
+	})
+}
+
+// Expectations waits until all the expectations of m are met, and reports
+// whether they were before ctx was done. Unlike m.AssertExpectations, it
+// doesn't fail the test while the expectations are unmet.
+func (c *Calls) Expectations(ctx context.Context, m *mock.Mock) bool {
+	return c.Until(ctx, func() bool {
+		return m.AssertExpectations(quietT{})
+	})
+}
+
+// quietT is a mock.TestingT that discards failures, so that expectations can
+// be checked without failing the test.
+type quietT struct{}
+
+func (quietT) Logf(string, ...interface{})   {}
+func (quietT) Errorf(string, ...interface{}) {}
+func (quietT) FailNow()                      {}
@@ -0,0 +1,57 @@
+package await
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCalled(t *testing.T) {
+	var c Calls
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		c.Record("Other")
+		c.Record("Get")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.True(t, c.Called(ctx, "Get"))
+	assert.Equal(t, 1, c.Count("Get"))
+	assert.Equal(t, 1, c.Count("Other"))
+	assert.Zero(t, c.Count("Put"))
+}
+
+func TestCalledTimeout(t *testing.T) {
+	var c Calls
+	c.Record("Other")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, c.Called(ctx, "Get"))
+}
+
+func TestExpectations(t *testing.T) {
+	var (
+		c Calls
+		m mock.Mock
+	)
+	m.On("Get").Return().Twice()
+	call := func() {
+		m.MethodCalled("Get")
+		c.Record("Get")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	call()
+	assert.False(t, c.Expectations(ctx, &m))
+
+	go call()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.True(t, c.Expectations(ctx, &m))
+}
@@ -10,12 +10,16 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"go/ast"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -35,6 +39,7 @@ import (
 	"github.com/vektra/mockery/v3/internal/logging"
 	"github.com/vektra/mockery/v3/internal/stackerr"
 	"github.com/vektra/mockery/v3/template_funcs"
+	"golang.org/x/mod/modfile"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -58,7 +63,9 @@ func NewInterface(name string, filename string, file *ast.File, pkg *packages.Pa
 
 // TemplateData is the data sent to the template for the config file.
 type TemplateData struct {
-	// ConfigDir is the directory of where the mockery config file is located.
+	// ConfigDir is the directory of where the mockery config file is located. For
+	// packages declared in an included config file, this is the directory of
+	// the included file.
 	ConfigDir string
 	// InterfaceDir is the directory of the interface being mocked.
 	InterfaceDir string
@@ -108,10 +115,45 @@ func NewDefaultKoanf(ctx context.Context) (*koanf.Koanf, error) {
 }
 
 type RootConfig struct {
-	Config     `koanf:",squash" yaml:",inline"`
+	Config `koanf:",squash" yaml:",inline"`
+	// Include is a list of glob patterns, relative to the directory of the root
+	// config file, that point to child config files. Each child config may only
+	// declare a `packages` section. The patterns support `**` to match any number
+	// of directories.
+	Include    []string                  `koanf:"include" yaml:"include,omitempty"`
 	Packages   map[string]*PackageConfig `koanf:"packages" yaml:"packages"`
 	koanf      *koanf.Koanf
 	configFile *pathlib.Path
+	// sources records where each parameter was set prior to the config being
+	// initialized.
+	sources *configSources
+}
+
+// ConfigFilePath returns the path of the root config file. The path is taken
+// from the MOCKERY_CONFIG environment variable or the --config flag. If neither
+// is set, the config file is searched for in the current working directory and
+// its parents.
+func ConfigFilePath(ctx context.Context, flags *pflag.FlagSet) (*pathlib.Path, error) {
+	log := zerolog.Ctx(ctx)
+
+	configFileFromEnv := os.Getenv("MOCKERY_CONFIG")
+	if configFileFromEnv != "" {
+		return pathlib.NewPath(configFileFromEnv), nil
+	}
+	configFileFromFlags, err := flags.GetString("config")
+	if err != nil {
+		return nil, fmt.Errorf("getting --config from flags: %w", err)
+	}
+	if configFileFromFlags != "" {
+		return pathlib.NewPath(configFileFromFlags), nil
+	}
+	log.Debug().Msg("config file not specified, searching")
+	configFile, err := internalConfig.FindConfig()
+	if err != nil {
+		return nil, fmt.Errorf("discovering mockery config: %w", err)
+	}
+	log.Debug().Str("config-file", configFile.String()).Msg("config file found")
+	return configFile, nil
 }
 
 func NewRootConfig(
@@ -147,26 +189,9 @@ func NewRootConfig(
 		koanf:  k,
 	}
 
-	configFileFromEnv := os.Getenv("MOCKERY_CONFIG")
-	if configFileFromEnv != "" {
-		configFile = pathlib.NewPath(configFileFromEnv)
-	}
-	if configFile == nil {
-		configFileFromFlags, err := flags.GetString("config")
-		if err != nil {
-			return nil, nil, fmt.Errorf("getting --config from flags: %w", err)
-		}
-		if configFileFromFlags != "" {
-			configFile = pathlib.NewPath(configFileFromFlags)
-		}
-	}
-	if configFile == nil {
-		log.Debug().Msg("config file not specified, searching")
-		configFile, err = internalConfig.FindConfig()
-		if err != nil {
-			return nil, k, fmt.Errorf("discovering mockery config: %w", err)
-		}
-		log.Debug().Str("config-file", configFile.String()).Msg("config file found")
+	configFile, err = ConfigFilePath(ctx, flags)
+	if err != nil {
+		return nil, k, err
 	}
 	rootConfig.configFile = configFile
 
@@ -218,6 +243,14 @@ func NewRootConfig(
 	}); err != nil {
 		return nil, k, fmt.Errorf("unmarshalling config: %w", err)
 	}
+	if err := rootConfig.loadIncludes(ctx); err != nil {
+		return nil, k, fmt.Errorf("loading included configs: %w", err)
+	}
+	configFileContents, err := configFile.ReadFile()
+	if err != nil {
+		return nil, k, fmt.Errorf("reading config file: %w", err)
+	}
+	rootConfig.sources = newConfigSources(&rootConfig, flags, configFileContents)
 	if err := rootConfig.Initialize(ctx); err != nil {
 		return nil, k, fmt.Errorf("initializing root config: %w", err)
 	}
@@ -228,6 +261,202 @@ func (c *RootConfig) ConfigFileUsed() *pathlib.Path {
 	return c.configFile
 }
 
+// childConfig is the schema of a config file referenced by the root config's
+// `include` parameter.
+type childConfig struct {
+	Packages map[string]*PackageConfig `koanf:"packages" yaml:"packages"`
+}
+
+// loadIncludes discovers the child config files matched by the `include` globs
+// and merges their packages into the root config. Packages from a child config
+// have their `config` parameter set to the child file so that the `ConfigDir`
+// template variable refers to the child's directory. A child config may only
+// declare packages in or below its own directory, and a package defined in more
+// than one file is an error.
+func (c *RootConfig) loadIncludes(ctx context.Context) error {
+	log := zerolog.Ctx(ctx)
+	if len(c.Include) == 0 {
+		return nil
+	}
+	if c.Packages == nil {
+		c.Packages = map[string]*PackageConfig{}
+	}
+	// packageSources maps the package path to the config file it was defined in.
+	packageSources := map[string]string{}
+	for pkgPath := range c.Packages {
+		packageSources[pkgPath] = c.configFile.String()
+	}
+
+	rootDir := c.configFile.Parent()
+	seenFiles := map[string]struct{}{}
+	for _, pattern := range c.Include {
+		matches, err := globFiles(rootDir.String(), pattern)
+		if err != nil {
+			return fmt.Errorf("evaluating include pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			log.Warn().Str("pattern", pattern).Msg("include pattern did not match any files")
+		}
+		for _, match := range matches {
+			childPath := pathlib.NewPath(match)
+			if childPath.Clean().String() == c.configFile.Clean().String() {
+				continue
+			}
+			if _, seen := seenFiles[match]; seen {
+				continue
+			}
+			seenFiles[match] = struct{}{}
+
+			fileLog := log.With().Str("included-config", match).Logger()
+			fileLog.Debug().Msg("loading included config")
+			child, err := loadChildConfig(match)
+			if err != nil {
+				return err
+			}
+			var childPrefix string
+			if len(child.Packages) != 0 {
+				childPrefix, err = importPathOfDir(childPath.Parent().String())
+				if err != nil {
+					return fmt.Errorf("resolving import path of included config %s: %w", match, err)
+				}
+			}
+			for pkgPath, pkgConfig := range child.Packages {
+				if pkgPath != childPrefix && !strings.HasPrefix(pkgPath, childPrefix+"/") {
+					return fmt.Errorf(
+						"included config %s declares package %s, which is outside of its directory (%s)",
+						match, pkgPath, childPrefix,
+					)
+				}
+				if existing, exists := packageSources[pkgPath]; exists {
+					return fmt.Errorf("package %s is defined in both %s and %s", pkgPath, existing, match)
+				}
+				packageSources[pkgPath] = match
+
+				if pkgConfig == nil {
+					pkgConfig = NewPackageConfig()
+				}
+				if pkgConfig.Config == nil {
+					pkgConfig.Config = &Config{}
+				}
+				if pkgConfig.Config.ConfigFile == nil {
+					pkgConfig.Config.ConfigFile = addr(match)
+				}
+				fileLog.Debug().Str(logging.LogKeyPackagePath, pkgPath).Msg("adding package from included config")
+				c.Packages[pkgPath] = pkgConfig
+			}
+		}
+	}
+	return nil
+}
+
+func loadChildConfig(path string) (*childConfig, error) {
+	k := koanf.New("|")
+	if err := k.Load(file.Provider(path), koanfYAML.Parser()); err != nil {
+		return nil, fmt.Errorf("loading included config %s: %w", path, err)
+	}
+	var child childConfig
+	if err := k.UnmarshalWithConf("", nil, koanf.UnmarshalConf{
+		DecoderConfig: &mapstructure.DecoderConfig{
+			ErrorUnused: true,
+			Result:      &child,
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("unmarshalling included config %s: %w", path, err)
+	}
+	return &child, nil
+}
+
+// importPathOfDir returns the import path of the package in dir, derived from
+// the go.mod file found in dir or one of its parents.
+func importPathOfDir(dir string) (string, error) {
+	for modDir := dir; ; {
+		b, err := os.ReadFile(filepath.Join(modDir, "go.mod"))
+		if err == nil {
+			modPath := modfile.ModulePath(b)
+			if modPath == "" {
+				return "", fmt.Errorf("%s has no module directive", filepath.Join(modDir, "go.mod"))
+			}
+			rel, err := filepath.Rel(modDir, dir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modPath, filepath.ToSlash(rel)), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(modDir)
+		if parent == modDir {
+			return "", fmt.Errorf("no go.mod found for %s", dir)
+		}
+		modDir = parent
+	}
+}
+
+// globFiles returns the files under root that match pattern. In addition to the
+// syntax supported by filepath.Match, a "**" path segment matches zero or more
+// directories.
+func globFiles(root string, pattern string) ([]string, error) {
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(root, pattern)
+	}
+	if !strings.Contains(pattern, "**") {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(matches)
+		return matches, nil
+	}
+
+	prefix, suffix, _ := strings.Cut(pattern, "**")
+	prefix = filepath.Clean(prefix)
+	suffix = strings.TrimPrefix(suffix, string(filepath.Separator))
+	if _, err := filepath.Match(suffix, ""); err != nil {
+		return nil, err
+	}
+
+	baseDirs, err := filepath.Glob(prefix)
+	if err != nil {
+		return nil, err
+	}
+	matches := []string{}
+	for _, baseDir := range baseDirs {
+		err := filepath.WalkDir(baseDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(baseDir, path)
+			if err != nil {
+				return err
+			}
+			// Try matching the suffix against every trailing portion of the
+			// relative path, which is what allows "**" to match zero or
+			// more directories.
+			parts := strings.Split(rel, string(filepath.Separator))
+			for i := range parts {
+				matched, err := filepath.Match(suffix, filepath.Join(parts[i:]...))
+				if err != nil {
+					return err
+				}
+				if matched {
+					matches = append(matches, path)
+					break
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
 // mergreStringMaps merges two (possibly nested) maps.
 func mergeStringMaps(src, dest map[string]any) {
 	for srcKey, srcValue := range src {
@@ -335,7 +564,11 @@ func (c *RootConfig) Initialize(ctx context.Context) error {
 		}
 		parentPkgConfig := c.Packages[recursivePackageName]
 		for _, subpkg := range subpkgs {
-			if c.ShouldExcludeSubpkg(subpkg) {
+			shouldExclude, err := c.ShouldExcludeSubpkg(subpkg)
+			if err != nil {
+				return err
+			}
+			if shouldExclude {
 				pkgLog.Debug().Msg("package was marked for exclusion")
 				continue
 			}
@@ -439,7 +672,33 @@ func (c PackageConfig) GetInterfaceConfig(ctx context.Context, interfaceName str
 	return ifaceConfig
 }
 
+// SelectionReason describes why an interface was or was not selected to be
+// generated.
+type SelectionReason string
+
+const (
+	// SelectedByAll means the interface was selected because `all: true` is set.
+	SelectedByAll SelectionReason = "all"
+	// SelectedExplicitly means the interface is listed in the package's
+	// `interfaces` section.
+	SelectedExplicitly SelectionReason = "explicit"
+	// SelectedByRegex means the interface matches `include-interface-regex`.
+	SelectedByRegex SelectionReason = "include-interface-regex"
+	// ExcludedByRegex means the interface matches `include-interface-regex`,
+	// but also matches `exclude-interface-regex`.
+	ExcludedByRegex SelectionReason = "exclude-interface-regex"
+	// NotSelected means the config does not mention the interface in any way.
+	NotSelected SelectionReason = "not-selected"
+)
+
 func (c PackageConfig) ShouldGenerateInterface(ctx context.Context, interfaceName string) (bool, error) {
+	shouldGenerate, _, err := c.InterfaceSelection(ctx, interfaceName)
+	return shouldGenerate, err
+}
+
+// InterfaceSelection returns whether the interface should be generated, along
+// with the reason for the decision.
+func (c PackageConfig) InterfaceSelection(ctx context.Context, interfaceName string) (bool, SelectionReason, error) {
 	log := zerolog.Ctx(ctx)
 	if *c.Config.All {
 		if *c.Config.IncludeInterfaceRegex != "" {
@@ -449,11 +708,11 @@ func (c PackageConfig) ShouldGenerateInterface(ctx context.Context, interfaceNam
 			log.Warn().Msg("interface config has both `all` and `exclude-interface-regex` set: `exclude-interface-regex` will be ignored")
 		}
 		log.Debug().Msg("`all: true` is set, interface should be generated")
-		return true, nil
+		return true, SelectedByAll, nil
 	}
 
 	if _, exists := c.Interfaces[interfaceName]; exists {
-		return true, nil
+		return true, SelectedExplicitly, nil
 	}
 
 	includeRegex := *c.Config.IncludeInterfaceRegex
@@ -462,30 +721,30 @@ func (c PackageConfig) ShouldGenerateInterface(ctx context.Context, interfaceNam
 		if excludeRegex != "" {
 			log.Warn().Msg("interface config has `exclude-interface-regex` set but not `include-interface-regex`: `exclude-interface-regex` will be ignored")
 		}
-		return false, nil
+		return false, NotSelected, nil
 	}
 	includedByRegex, err := regexp.MatchString(includeRegex, interfaceName)
 	if err != nil {
-		return false, fmt.Errorf("evaluating `include-interface-regex`: %w", err)
+		return false, NotSelected, fmt.Errorf("evaluating `include-interface-regex`: %w", err)
 	}
 	if !includedByRegex {
 		log.Debug().Msg("interface does not match include-interface-regex")
-		return false, nil
+		return false, NotSelected, nil
 	}
 	log.Debug().Msg("interface matches include-interface-regex")
 	if excludeRegex == "" {
-		return true, nil
+		return true, SelectedByRegex, nil
 	}
 	excludedByRegex, err := regexp.MatchString(excludeRegex, interfaceName)
 	if err != nil {
-		return false, fmt.Errorf("evaluating `exclude-interface-regex`: %w", err)
+		return false, NotSelected, fmt.Errorf("evaluating `exclude-interface-regex`: %w", err)
 	}
 	if excludedByRegex {
 		log.Debug().Msg("interface matches exclude-interface-regex")
-		return false, nil
+		return false, ExcludedByRegex, nil
 	}
 	log.Debug().Msg("interface does not match exclude-interface-regex")
-	return true, nil
+	return true, SelectedByRegex, nil
 }
 
 type InterfaceConfig struct {
@@ -549,17 +808,17 @@ func (c *Config) FilePath() *pathlib.Path {
 	return pathlib.NewPath(*c.Dir).Join(*c.FileName).Clean()
 }
 
-func (c *Config) ShouldExcludeSubpkg(pkgPath string) bool {
+func (c *Config) ShouldExcludeSubpkg(pkgPath string) (bool, error) {
 	for _, regex := range c.ExcludeSubpkgRegex {
 		matched, err := regexp.MatchString(regex, pkgPath)
 		if err != nil {
-			panic(err)
+			return false, fmt.Errorf("evaluating `exclude-subpkg-regex`: %w", err)
 		}
 		if matched {
-			return true
+			return true, nil
 		}
 	}
-	return false
+	return false, nil
 }
 
 func IsAutoGenerated(path *pathlib.Path) (bool, error) {
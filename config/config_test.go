@@ -54,3 +54,157 @@ packages:
 		})
 	}
 }
+
+func TestNewRootConfigInclude(t *testing.T) {
+	tests := []struct {
+		name         string
+		rootConfig   string
+		childConfigs map[string]string
+		wantPackages map[string]string
+		wantErr      string
+	}{
+		{
+			name: "explicit and recursive globs",
+			rootConfig: `
+include:
+  - "team-a/.mockery.yml"
+  - "services/**/.mockery.yml"
+packages:
+  github.com/foo/root:
+`,
+			childConfigs: map[string]string{
+				"team-a/.mockery.yml": `
+packages:
+  github.com/foo/team-a:
+  github.com/foo/team-a/sub:
+`,
+				"services/b/c/.mockery.yml": `
+packages:
+  github.com/foo/services/b/c:
+    config:
+      structname: "Fake{{.InterfaceName}}"
+`,
+			},
+			wantPackages: map[string]string{
+				"github.com/foo/root":         "",
+				"github.com/foo/team-a":       "team-a/.mockery.yml",
+				"github.com/foo/team-a/sub":   "team-a/.mockery.yml",
+				"github.com/foo/services/b/c": "services/b/c/.mockery.yml",
+			},
+		},
+		{
+			name: "package defined in root and child",
+			rootConfig: `
+include:
+  - "*/.mockery.yml"
+packages:
+  github.com/foo/team-a:
+`,
+			childConfigs: map[string]string{
+				"team-a/.mockery.yml": `
+packages:
+  github.com/foo/team-a:
+`,
+			},
+			wantErr: "package github.com/foo/team-a is defined in both",
+		},
+		{
+			name: "child declares package outside of its directory",
+			rootConfig: `
+include:
+  - "*/.mockery.yml"
+`,
+			childConfigs: map[string]string{
+				"team-a/.mockery.yml": `
+packages:
+  github.com/foo/team-ab:
+`,
+			},
+			wantErr: "declares package github.com/foo/team-ab, which is outside of its directory (github.com/foo/team-a)",
+		},
+		{
+			name: "child declares non-package parameter",
+			rootConfig: `
+include:
+  - "*/.mockery.yml"
+`,
+			childConfigs: map[string]string{
+				"team-a/.mockery.yml": `
+template: matryer
+packages:
+  github.com/foo/a:
+`,
+			},
+			wantErr: "has invalid keys: template",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := pathlib.NewPath(t.TempDir())
+			require.NoError(t, tmpDir.Join("go.mod").WriteFile([]byte("module github.com/foo\n")))
+			configFile := tmpDir.Join(".mockery.yml")
+			require.NoError(t, configFile.WriteFile([]byte(tt.rootConfig)))
+			for relPath, contents := range tt.childConfigs {
+				childFile := tmpDir.Join(relPath)
+				require.NoError(t, childFile.Parent().MkdirAll())
+				require.NoError(t, childFile.WriteFile([]byte(contents)))
+			}
+
+			flags := pflag.NewFlagSet("test", pflag.ExitOnError)
+			flags.String("config", "", "")
+			require.NoError(t, flags.Parse([]string{"--config", configFile.String()}))
+
+			rootConfig, _, err := NewRootConfig(context.Background(), flags)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rootConfig.Packages, len(tt.wantPackages))
+			for pkgPath, childPath := range tt.wantPackages {
+				pkgConfig, err := rootConfig.GetPackageConfig(context.Background(), pkgPath)
+				require.NoError(t, err)
+				wantConfigFile := configFile.String()
+				if childPath != "" {
+					wantConfigFile = tmpDir.Join(childPath).String()
+				}
+				assert.Equal(t, wantConfigFile, *pkgConfig.Config.ConfigFile)
+			}
+		})
+	}
+}
+
+func TestPackageConfigInterfaceSelection(t *testing.T) {
+	tests := []struct {
+		name         string
+		all          bool
+		include      string
+		exclude      string
+		interfaces   map[string]*InterfaceConfig
+		wantSelected bool
+		wantReason   SelectionReason
+	}{
+		{name: "all", all: true, wantSelected: true, wantReason: SelectedByAll},
+		{name: "explicit", interfaces: map[string]*InterfaceConfig{"Foo": NewInterfaceConfig()}, wantSelected: true, wantReason: SelectedExplicitly},
+		{name: "include regex", include: "^Fo", wantSelected: true, wantReason: SelectedByRegex},
+		{name: "exclude regex", include: "^Fo", exclude: "oo$", wantSelected: false, wantReason: ExcludedByRegex},
+		{name: "include regex no match", include: "^Bar", wantSelected: false, wantReason: NotSelected},
+		{name: "not mentioned", wantSelected: false, wantReason: NotSelected},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewPackageConfig()
+			c.Config.All = &tt.all
+			c.Config.IncludeInterfaceRegex = &tt.include
+			c.Config.ExcludeInterfaceRegex = &tt.exclude
+			if tt.interfaces != nil {
+				c.Interfaces = tt.interfaces
+			}
+			selected, reason, err := c.InterfaceSelection(context.Background(), "Foo")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSelected, selected)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Source describes where the value of a config parameter came from.
+type Source string
+
+const (
+	SourceDefault   Source = "default"
+	SourceEnv       Source = "env"
+	SourceFlag      Source = "flag"
+	SourceRoot      Source = "root"
+	SourcePackage   Source = "package"
+	SourceInterface Source = "interface"
+)
+
+// SourceConfigs returns the Source of a parameter set in the i-th element of an
+// interface's `configs` list.
+func SourceConfigs(i int) Source {
+	return Source(fmt.Sprintf("configs[%d]", i))
+}
+
+type keySet map[string]struct{}
+
+// explicitKeys returns the set of parameters that were given a value in c. The
+// keys of schemaless maps like `template-data` are included in the form of
+// "template-data.key".
+func explicitKeys(c *Config) keySet {
+	keys := keySet{}
+	if c == nil {
+		return keys
+	}
+	v := reflect.ValueOf(c).Elem()
+	for _, field := range koanfFields(v.Type()) {
+		fieldValue := v.FieldByIndex(field.Field.Index)
+		switch fieldValue.Kind() {
+		case reflect.Pointer, reflect.Map, reflect.Slice:
+			if fieldValue.IsNil() {
+				continue
+			}
+		}
+		keys[field.Key] = struct{}{}
+		if subMap, ok := fieldValue.Interface().(map[string]any); ok {
+			for subKey := range subMap {
+				keys[field.Key+"."+subKey] = struct{}{}
+			}
+		}
+	}
+	return keys
+}
+
+// configSources records which parameters were explicitly set at each level of
+// the config hierarchy. It must be captured before the config is initialized,
+// as initialization merges the parent values into each level.
+type configSources struct {
+	root       map[string]Source
+	packages   map[string]keySet
+	interfaces map[string]map[string]keySet
+	configs    map[string]map[string][]keySet
+}
+
+func newConfigSources(c *RootConfig, flags *pflag.FlagSet, configFileContents []byte) *configSources {
+	fileKeys := map[string]any{}
+	// Errors are ignored here as the file has already been successfully
+	// loaded by koanf.
+	_ = yaml.Unmarshal(configFileContents, &fileKeys)
+
+	sources := &configSources{
+		root:       map[string]Source{},
+		packages:   map[string]keySet{},
+		interfaces: map[string]map[string]keySet{},
+		configs:    map[string]map[string][]keySet{},
+	}
+	for _, field := range koanfFields(reflect.TypeOf(Config{})) {
+		envName := "MOCKERY_" + strings.ToUpper(strings.ReplaceAll(field.Key, "-", "_"))
+		_, inFile := fileKeys[field.Key]
+		switch {
+		case flags != nil && flags.Lookup(field.Key) != nil && flags.Changed(field.Key):
+			sources.root[field.Key] = SourceFlag
+		case inFile:
+			sources.root[field.Key] = SourceRoot
+		case os.Getenv(envName) != "":
+			sources.root[field.Key] = SourceEnv
+		default:
+			sources.root[field.Key] = SourceDefault
+		}
+		if subMap, ok := fileKeys[field.Key].(map[string]any); ok {
+			for subKey := range subMap {
+				sources.root[field.Key+"."+subKey] = SourceRoot
+			}
+		}
+	}
+	for pkgPath, pkgConfig := range c.Packages {
+		if pkgConfig == nil {
+			continue
+		}
+		sources.packages[pkgPath] = explicitKeys(pkgConfig.Config)
+		sources.interfaces[pkgPath] = map[string]keySet{}
+		sources.configs[pkgPath] = map[string][]keySet{}
+		for ifaceName, ifaceConfig := range pkgConfig.Interfaces {
+			if ifaceConfig == nil {
+				continue
+			}
+			sources.interfaces[pkgPath][ifaceName] = explicitKeys(ifaceConfig.Config)
+			for _, subConfig := range ifaceConfig.Configs {
+				sources.configs[pkgPath][ifaceName] = append(sources.configs[pkgPath][ifaceName], explicitKeys(subConfig))
+			}
+		}
+	}
+	return sources
+}
+
+// Provenance returns, for each parameter of the config used to generate the
+// configIdx-th mock of the given interface, where the parameter's value came
+// from. Keys of `template-data` are reported individually in the form of
+// "template-data.key".
+func (c *RootConfig) Provenance(pkgPath string, interfaceName string, configIdx int) map[string]Source {
+	provenance := map[string]Source{}
+	if c.sources == nil {
+		return provenance
+	}
+
+	// Packages injected by `recursive: true` inherit the config of the
+	// closest recursive parent that was explicitly configured.
+	pkgKeys, explicitPkg := c.sources.packages[pkgPath]
+	if !explicitPkg {
+		var parent string
+		for candidate, candidateKeys := range c.sources.packages {
+			candidateConfig := c.Packages[candidate]
+			if candidateConfig == nil || candidateConfig.Config.Recursive == nil || !*candidateConfig.Config.Recursive {
+				continue
+			}
+			if strings.HasPrefix(pkgPath, candidate+"/") && len(candidate) > len(parent) {
+				parent = candidate
+				pkgKeys = candidateKeys
+			}
+		}
+	}
+	ifaceKeys := c.sources.interfaces[pkgPath][interfaceName]
+	var subConfigKeys keySet
+	if subConfigs := c.sources.configs[pkgPath][interfaceName]; configIdx < len(subConfigs) {
+		subConfigKeys = subConfigs[configIdx]
+	}
+
+	keys := keySet{}
+	for _, field := range koanfFields(reflect.TypeOf(Config{})) {
+		keys[field.Key] = struct{}{}
+	}
+	for _, levelKeys := range []keySet{subConfigKeys, ifaceKeys, pkgKeys} {
+		for key := range levelKeys {
+			keys[key] = struct{}{}
+		}
+	}
+	for key := range c.sources.root {
+		keys[key] = struct{}{}
+	}
+
+	for key := range keys {
+		if _, ok := subConfigKeys[key]; ok {
+			provenance[key] = SourceConfigs(configIdx)
+		} else if _, ok := ifaceKeys[key]; ok {
+			provenance[key] = SourceInterface
+		} else if _, ok := pkgKeys[key]; ok {
+			provenance[key] = SourcePackage
+		} else if source, ok := c.sources.root[key]; ok {
+			provenance[key] = source
+		} else {
+			provenance[key] = SourceDefault
+		}
+	}
+	return provenance
+}
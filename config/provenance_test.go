@@ -0,0 +1,62 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chigopher/pathlib"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootConfigProvenance(t *testing.T) {
+	t.Setenv("MOCKERY_FORMATTER", "gofmt")
+
+	configFile := pathlib.NewPath(t.TempDir()).Join("config.yaml")
+	require.NoError(t, configFile.WriteFile([]byte(`
+dir: "mocks"
+template-data:
+  boilerplate-file: ./boilerplate.txt
+packages:
+  github.com/foo/bar:
+    config:
+      filename: "mocks_bar.go"
+    interfaces:
+      Baz:
+        config:
+          pkgname: "mocks"
+        configs:
+          - structname: BazOne
+            template-data:
+              with-resets: true
+          - {}
+`)))
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("config", "", "")
+	flags.String("log-level", "", "")
+	require.NoError(t, flags.Parse([]string{"--config", configFile.String(), "--log-level", "debug"}))
+
+	rootConfig, _, err := NewRootConfig(context.Background(), flags)
+	require.NoError(t, err)
+
+	provenance := rootConfig.Provenance("github.com/foo/bar", "Baz", 0)
+	for key, want := range map[string]Source{
+		"all":                            SourceDefault,
+		"formatter":                      SourceEnv,
+		"log-level":                      SourceFlag,
+		"dir":                            SourceRoot,
+		"filename":                       SourcePackage,
+		"pkgname":                        SourceInterface,
+		"structname":                     SourceConfigs(0),
+		"template-data.boilerplate-file": SourceRoot,
+		"template-data.with-resets":      SourceConfigs(0),
+	} {
+		assert.Equal(t, want, provenance[key], key)
+	}
+
+	provenance = rootConfig.Provenance("github.com/foo/bar", "Baz", 1)
+	assert.Equal(t, SourceDefault, provenance["structname"])
+	assert.Equal(t, SourceInterface, provenance["pkgname"])
+}
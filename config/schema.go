@@ -0,0 +1,148 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// regexParameters is the set of parameters whose values are Go regular
+// expressions.
+var regexParameters = map[string]struct{}{
+	"exclude-interface-regex": {},
+	"exclude-subpkg-regex":    {},
+	"include-interface-regex": {},
+}
+
+// koanfField is a struct field that is decoded from the config file.
+type koanfField struct {
+	Key   string
+	Field reflect.StructField
+}
+
+// koanfFields returns the fields of the struct type t that are decoded by koanf,
+// in declaration order. Fields marked with ",squash" are flattened into the
+// parent struct.
+func koanfFields(t reflect.Type) []koanfField {
+	fields := []koanfField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, hasTag := field.Tag.Lookup("koanf")
+		if !hasTag {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if opts == "squash" {
+			fields = append(fields, koanfFields(field.Type)...)
+			continue
+		}
+		fields = append(fields, koanfField{Key: name, Field: field})
+	}
+	return fields
+}
+
+// schemaGenerator builds a JSON schema out of the config structs. Struct types
+// are placed in the `definitions` section and referenced by name.
+type schemaGenerator struct {
+	definitions map[string]any
+}
+
+func (g *schemaGenerator) definitionName(t reflect.Type) string {
+	switch t {
+	case reflect.TypeOf(Config{}):
+		return "config"
+	case reflect.TypeOf(PackageConfig{}):
+		return "package-config"
+	case reflect.TypeOf(InterfaceConfig{}):
+		return "interface-config"
+	case reflect.TypeOf(ReplaceType{}):
+		return "replace-type"
+	}
+	panic(fmt.Sprintf("no schema definition name for %s", t))
+}
+
+func (g *schemaGenerator) objectSchema(t reflect.Type) map[string]any {
+	properties := map[string]any{}
+	for _, field := range koanfFields(t) {
+		propertySchema := g.typeSchema(field.Field.Type)
+		if _, isRegex := regexParameters[field.Key]; isRegex {
+			if field.Field.Type.Kind() == reflect.Slice {
+				propertySchema["items"].(map[string]any)["format"] = "regex"
+			} else {
+				propertySchema["format"] = "regex"
+			}
+		}
+		properties[field.Key] = propertySchema
+	}
+	return map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties":           properties,
+	}
+}
+
+func (g *schemaGenerator) typeSchema(t reflect.Type) map[string]any {
+	nullable := false
+	if t.Kind() == reflect.Pointer {
+		nullable = t.Elem().Kind() == reflect.Struct
+		t = t.Elem()
+	}
+	var schema map[string]any
+	switch t.Kind() {
+	case reflect.Bool:
+		schema = map[string]any{"type": "boolean"}
+	case reflect.String:
+		schema = map[string]any{"type": "string"}
+	case reflect.Slice:
+		schema = map[string]any{
+			"type":  "array",
+			"items": g.typeSchema(t.Elem()),
+		}
+	case reflect.Map:
+		schema = map[string]any{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			schema["additionalProperties"] = g.typeSchema(t.Elem())
+		}
+	case reflect.Struct:
+		name := g.definitionName(t)
+		if _, exists := g.definitions[name]; !exists {
+			// Reserve the name before recursing in case the type refers
+			// to itself.
+			g.definitions[name] = nil
+			g.definitions[name] = g.objectSchema(t)
+		}
+		schema = map[string]any{"$ref": "#/definitions/" + name}
+	default:
+		schema = map[string]any{}
+	}
+	if nullable {
+		return map[string]any{
+			"anyOf": []any{
+				map[string]any{"type": "null"},
+				schema,
+			},
+		}
+	}
+	return schema
+}
+
+// JSONSchema returns the JSON schema of the .mockery.yml config file. The
+// schema is derived from the RootConfig struct and can be used by editors to
+// provide completion and validation.
+func JSONSchema() ([]byte, error) {
+	g := &schemaGenerator{definitions: map[string]any{}}
+	root := g.objectSchema(reflect.TypeOf(RootConfig{}))
+	root["$schema"] = "http://json-schema.org/draft-07/schema#"
+	root["title"] = "vektra/mockery config"
+	root["definitions"] = g.definitions
+
+	b, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshalling JSON schema: %w", err)
+	}
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchemaUpToDate(t *testing.T) {
+	schema, err := JSONSchema()
+	require.NoError(t, err)
+
+	published, err := os.ReadFile("../docs/schemas/mockery.schema.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(published), string(schema), "published schema is stale, regenerate it with `mockery config schema > docs/schemas/mockery.schema.json`")
+}
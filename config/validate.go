@@ -0,0 +1,236 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationError describes a problem found in a config file, along with its
+// location.
+type ValidationError struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+}
+
+// validator walks a yaml.Node tree and compares it against the koanf schema of
+// the config structs. Unlike unmarshalling through koanf, it retains the line
+// and column of each problem.
+type validator struct {
+	file   string
+	errors []ValidationError
+}
+
+func (v *validator) errorf(node *yaml.Node, format string, args ...any) {
+	v.errors = append(v.errors, ValidationError{
+		File:    v.file,
+		Line:    node.Line,
+		Column:  node.Column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+func nodeKind(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "map"
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.ScalarNode:
+		switch node.Tag {
+		case "!!bool":
+			return "boolean"
+		case "!!str":
+			return "string"
+		case "!!int", "!!float":
+			return "number"
+		}
+		return node.Tag
+	}
+	return "unknown"
+}
+
+func joinKeyPath(parent string, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func (v *validator) validateStruct(node *yaml.Node, t reflect.Type, keyPath string) {
+	node = resolveAlias(node)
+	if isNull(node) {
+		return
+	}
+	if node.Kind != yaml.MappingNode {
+		v.errorf(node, "'%s' must be a map, got %s", keyPath, nodeKind(node))
+		return
+	}
+	fields := map[string]reflect.StructField{}
+	for _, field := range koanfFields(t) {
+		fields[field.Key] = field.Field
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		valueNode := node.Content[i+1]
+		if keyNode.Value == "<<" && keyNode.Tag == "!!merge" {
+			merged := resolveAlias(valueNode)
+			if merged.Kind == yaml.SequenceNode {
+				for _, mergedItem := range merged.Content {
+					v.validateStruct(mergedItem, t, keyPath)
+				}
+			} else {
+				v.validateStruct(merged, t, keyPath)
+			}
+			continue
+		}
+		field, known := fields[keyNode.Value]
+		if !known {
+			if keyPath == "" {
+				v.errorf(keyNode, "unknown parameter '%s'", keyNode.Value)
+			} else {
+				v.errorf(keyNode, "unknown parameter '%s' in '%s'", keyNode.Value, keyPath)
+			}
+			continue
+		}
+		fieldPath := joinKeyPath(keyPath, keyNode.Value)
+		v.validateValue(valueNode, field.Type, fieldPath)
+		if _, isRegex := regexParameters[keyNode.Value]; isRegex {
+			v.validateRegex(valueNode, fieldPath)
+		}
+	}
+}
+
+func (v *validator) validateValue(node *yaml.Node, t reflect.Type, keyPath string) {
+	node = resolveAlias(node)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if isNull(node) {
+		return
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!bool" {
+			v.errorf(node, "'%s' must be a boolean, got %s", keyPath, nodeKind(node))
+		}
+	case reflect.String:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+			v.errorf(node, "'%s' must be a string, got %s", keyPath, nodeKind(node))
+		}
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			v.errorf(node, "'%s' must be a list, got %s", keyPath, nodeKind(node))
+			return
+		}
+		for idx, item := range node.Content {
+			v.validateValue(item, t.Elem(), fmt.Sprintf("%s[%d]", keyPath, idx))
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			v.errorf(node, "'%s' must be a map, got %s", keyPath, nodeKind(node))
+			return
+		}
+		if t.Elem().Kind() == reflect.Interface {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v.validateValue(node.Content[i+1], t.Elem(), fmt.Sprintf("%s[%s]", keyPath, node.Content[i].Value))
+		}
+	case reflect.Struct:
+		v.validateStruct(node, t, keyPath)
+	}
+}
+
+func (v *validator) validateRegex(node *yaml.Node, keyPath string) {
+	node = resolveAlias(node)
+	regexNodes := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		regexNodes = node.Content
+	}
+	for _, regexNode := range regexNodes {
+		regexNode = resolveAlias(regexNode)
+		if regexNode.Kind != yaml.ScalarNode || regexNode.Tag != "!!str" {
+			continue
+		}
+		if _, err := regexp.Compile(regexNode.Value); err != nil {
+			v.errorf(regexNode, "'%s' is not a valid regular expression: %v", keyPath, err)
+		}
+	}
+}
+
+func validateBytes(file string, b []byte, t reflect.Type) ([]ValidationError, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(b, &document); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", file, err)
+	}
+	v := &validator{file: file}
+	for _, node := range document.Content {
+		v.validateStruct(node, t, "")
+	}
+	return v.errors, nil
+}
+
+// ValidateFile checks the config file at path for unknown parameters, values
+// of the wrong type and invalid regular expressions. Child config files listed
+// in the `include` parameter are validated as well. An error is returned only
+// if the files could not be read or parsed; problems with the config itself
+// are returned as a list of ValidationError.
+func ValidateFile(path string) ([]ValidationError, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	validationErrors, err := validateBytes(path, b, reflect.TypeOf(RootConfig{}))
+	if err != nil {
+		return nil, err
+	}
+
+	var root RootConfig
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		// The type errors have already been reported above.
+		return validationErrors, nil
+	}
+	for _, pattern := range root.Include {
+		matches, err := globFiles(filepath.Dir(path), pattern)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating include pattern %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			if filepath.Clean(match) == filepath.Clean(path) {
+				continue
+			}
+			childBytes, err := os.ReadFile(match)
+			if err != nil {
+				return nil, fmt.Errorf("reading included config file: %w", err)
+			}
+			childErrors, err := validateBytes(match, childBytes, reflect.TypeOf(childConfig{}))
+			if err != nil {
+				return nil, err
+			}
+			validationErrors = append(validationErrors, childErrors...)
+		}
+	}
+	return validationErrors, nil
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/chigopher/pathlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       string
+		childConfigs map[string]string
+		want         []string
+	}{
+		{
+			name: "valid config",
+			config: `
+_anchors: &pkg_config
+  all: True
+template: testify
+exclude-subpkg-regex: ["foo.*"]
+packages:
+  github.com/foo/bar:
+    config:
+      <<: *pkg_config
+      dir: "{{.InterfaceDir}}"
+    interfaces:
+      Foo:
+        configs:
+          - {}
+          - structname: MockFooTwo
+  github.com/foo/baz:
+    config: *pkg_config
+`,
+			want: []string{},
+		},
+		{
+			name: "unknown keys",
+			config: `
+struct-name: Foo
+packages:
+  github.com/foo/bar:
+    config:
+      inpackage: True
+`,
+			want: []string{
+				"config.yml:2:1: unknown parameter 'struct-name'",
+				"config.yml:6:7: unknown parameter 'inpackage' in 'packages[github.com/foo/bar].config'",
+			},
+		},
+		{
+			name: "wrong types",
+			config: `
+all: "yes"
+template: 1
+exclude-subpkg-regex: foo
+`,
+			want: []string{
+				"config.yml:2:6: 'all' must be a boolean, got string",
+				"config.yml:3:11: 'template' must be a string, got number",
+				"config.yml:4:23: 'exclude-subpkg-regex' must be a list, got string",
+			},
+		},
+		{
+			name: "invalid regex",
+			config: `
+exclude-subpkg-regex:
+  - "[a-"
+packages:
+  github.com/foo/bar:
+    config:
+      include-interface-regex: "(("
+`,
+			want: []string{
+				"config.yml:3:5: 'exclude-subpkg-regex' is not a valid regular expression: error parsing regexp: missing closing ]: `[a-`",
+				"config.yml:7:32: 'packages[github.com/foo/bar].config.include-interface-regex' is not a valid regular expression: error parsing regexp: missing closing ): `((`",
+			},
+		},
+		{
+			name: "included config",
+			config: `
+include: ["*/.mockery.yml"]
+`,
+			childConfigs: map[string]string{
+				"child/.mockery.yml": `
+template: matryer
+packages:
+  github.com/foo/bar:
+`,
+			},
+			want: []string{
+				"child/.mockery.yml:2:1: unknown parameter 'template'",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := pathlib.NewPath(t.TempDir())
+			configFile := tmpDir.Join("config.yml")
+			require.NoError(t, configFile.WriteFile([]byte(tt.config)))
+			for relPath, contents := range tt.childConfigs {
+				childFile := tmpDir.Join(relPath)
+				require.NoError(t, childFile.Parent().MkdirAll())
+				require.NoError(t, childFile.WriteFile([]byte(contents)))
+			}
+
+			validationErrors, err := ValidateFile(configFile.String())
+			require.NoError(t, err)
+
+			got := []string{}
+			for _, validationErr := range validationErrors {
+				relPath, err := pathlib.NewPath(validationErr.File).RelativeTo(tmpDir)
+				require.NoError(t, err)
+				validationErr.File = relPath.String()
+				got = append(got, validationErr.Error())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package test_replayer_interface_return
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/chigopher/pathlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayerInterfaceReturn(t *testing.T) {
+	t.Parallel()
+	outfile := pathlib.NewPath("./mocks_replayer_test.go")
+	//nolint:errcheck
+	defer outfile.Remove()
+
+	out, err := exec.Command(
+		"go", "run", "github.com/vektra/mockery/v3",
+		"--config", "./.mockery.yml").CombinedOutput()
+	assert.Error(t, err)
+	expectedString := "replayer: Opener.Open returns io.Reader, an interface type that can't be decoded from a recording"
+	assert.True(t, strings.Contains(string(out), expectedString), "expected string in stdout not found: \"%s\"", expectedString)
+	exists, err := outfile.Exists()
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,233 @@
+// Package fuzz implements the byte stream consumed by the fakes generated with
+// the `fuzz` template.
+//
+// A Source turns the input of a native Go fuzz test into values. Fakes draw
+// their return values from a shared Source, which lets the fuzzer explore how
+// the code under test handles whatever its dependencies return:
+//
+//	func FuzzClient(f *testing.F) {
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			src := fuzz.NewSource(data)
+//			client := NewClient(NewFuzzRequester(src))
+//			_ = client.Fetch()
+//		})
+//	}
+//
+// Once the input is exhausted, a Source returns zero values, nil pointers and
+// nil errors.
+package fuzz
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// MaxLen bounds the length of the strings, slices and maps drawn from a Source.
+const MaxLen = 16
+
+// maxDepth bounds the nesting of the values built by Fill, so that recursive
+// types terminate.
+const maxDepth = 8
+
+// ErrFuzz is wrapped by every error drawn from a Source.
+var ErrFuzz = errors.New("fuzz: error")
+
+// Source is a stream of values read from fuzzer input. It is not safe for
+// concurrent use.
+type Source struct {
+	data []byte
+}
+
+// NewSource returns a Source that reads from data.
+func NewSource(data []byte) *Source {
+	return &Source{data: data}
+}
+
+// Exhausted reports whether all the input has been consumed.
+func (s *Source) Exhausted() bool {
+	return len(s.data) == 0
+}
+
+// read returns the next n bytes of input, padded with zeros once the input is
+// exhausted.
+func (s *Source) read(n int) []byte {
+	buf := make([]byte, n)
+	s.data = s.data[copy(buf, s.data):]
+	return buf
+}
+
+// Bool returns the next bool.
+func (s *Source) Bool() bool {
+	return s.read(1)[0]&1 == 1
+}
+
+// Uint8 returns the next uint8.
+func (s *Source) Uint8() uint8 {
+	return s.read(1)[0]
+}
+
+// Uint16 returns the next uint16.
+func (s *Source) Uint16() uint16 {
+	return binary.BigEndian.Uint16(s.read(2))
+}
+
+// Uint32 returns the next uint32.
+func (s *Source) Uint32() uint32 {
+	return binary.BigEndian.Uint32(s.read(4))
+}
+
+// Uint64 returns the next uint64.
+func (s *Source) Uint64() uint64 {
+	return binary.BigEndian.Uint64(s.read(8))
+}
+
+// Uint returns the next uint.
+func (s *Source) Uint() uint {
+	return uint(s.Uint64())
+}
+
+// Uintptr returns the next uintptr.
+func (s *Source) Uintptr() uintptr {
+	return uintptr(s.Uint64())
+}
+
+// Int8 returns the next int8.
+func (s *Source) Int8() int8 {
+	return int8(s.Uint8())
+}
+
+// Int16 returns the next int16.
+func (s *Source) Int16() int16 {
+	return int16(s.Uint16())
+}
+
+// Int32 returns the next int32.
+func (s *Source) Int32() int32 {
+	return int32(s.Uint32())
+}
+
+// Int64 returns the next int64.
+func (s *Source) Int64() int64 {
+	return int64(s.Uint64())
+}
+
+// Int returns the next int.
+func (s *Source) Int() int {
+	return int(s.Uint64())
+}
+
+// Float32 returns the next float32. It may be NaN or infinite.
+func (s *Source) Float32() float32 {
+	return math.Float32frombits(s.Uint32())
+}
+
+// Float64 returns the next float64. It may be NaN or infinite.
+func (s *Source) Float64() float64 {
+	return math.Float64frombits(s.Uint64())
+}
+
+// Complex64 returns the next complex64.
+func (s *Source) Complex64() complex64 {
+	return complex(s.Float32(), s.Float32())
+}
+
+// Complex128 returns the next complex128.
+func (s *Source) Complex128() complex128 {
+	return complex(s.Float64(), s.Float64())
+}
+
+// Len returns a length between 0 and MaxLen.
+func (s *Source) Len() int {
+	return int(s.Uint8()) % (MaxLen + 1)
+}
+
+// Text returns a string of up to MaxLen bytes.
+func (s *Source) Text() string {
+	return string(s.read(s.Len()))
+}
+
+// Err returns either nil or an error wrapping ErrFuzz.
+func (s *Source) Err() error {
+	if !s.Bool() {
+		return nil
+	}
+	return fmt.Errorf("%w %d", ErrFuzz, s.Uint8())
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Fill sets the value pointed to by ptr to a value drawn from the source.
+// Pointers may be nil, errors may be nil or wrap ErrFuzz, and strings, slices
+// and maps hold up to MaxLen elements. Exported struct fields are filled in
+// turn. Other interfaces, functions, channels and unexported struct fields are
+// left untouched.
+func (s *Source) Fill(ptr any) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		panic(fmt.Sprintf("fuzz: Fill called with %T, want a non-nil pointer", ptr))
+	}
+	s.fill(v.Elem(), 0)
+}
+
+func (s *Source) fill(v reflect.Value, depth int) {
+	if depth > maxDepth {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(s.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(s.Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(s.Uint64())
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(s.Float64())
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(s.Complex128())
+	case reflect.String:
+		v.SetString(s.Text())
+	case reflect.Slice:
+		n := s.Len()
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := range n {
+			s.fill(v.Index(i), depth+1)
+		}
+	case reflect.Array:
+		for i := range v.Len() {
+			s.fill(v.Index(i), depth+1)
+		}
+	case reflect.Map:
+		n := s.Len()
+		v.Set(reflect.MakeMapWithSize(v.Type(), n))
+		for range n {
+			key := reflect.New(v.Type().Key()).Elem()
+			elem := reflect.New(v.Type().Elem()).Elem()
+			s.fill(key, depth+1)
+			s.fill(elem, depth+1)
+			v.SetMapIndex(key, elem)
+		}
+	case reflect.Pointer:
+		if !s.Bool() {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		elem := reflect.New(v.Type().Elem())
+		s.fill(elem.Elem(), depth+1)
+		v.Set(elem)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Field(i).CanSet() {
+				s.fill(v.Field(i), depth+1)
+			}
+		}
+	case reflect.Interface:
+		if v.Type() == errorType {
+			if err := s.Err(); err != nil {
+				v.Set(reflect.ValueOf(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package fuzz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceBasicValues(t *testing.T) {
+	src := NewSource([]byte{
+		1,          // Bool
+		0x01, 0x02, // Uint16
+		3, 'a', 'b', 'c', // Text
+		1, 7, // Err
+		0, // Err
+	})
+	assert.True(t, src.Bool())
+	assert.Equal(t, uint16(0x0102), src.Uint16())
+	assert.Equal(t, "abc", src.Text())
+	err := src.Err()
+	assert.ErrorIs(t, err, ErrFuzz)
+	assert.EqualError(t, err, "fuzz: error 7")
+	assert.NoError(t, src.Err())
+	assert.True(t, src.Exhausted())
+}
+
+func TestSourceExhausted(t *testing.T) {
+	src := NewSource([]byte{0xff})
+	assert.Equal(t, uint32(0xff000000), src.Uint32())
+	assert.Equal(t, 0, src.Int())
+	assert.Equal(t, "", src.Text())
+	assert.NoError(t, src.Err())
+	assert.Equal(t, 0, src.Len())
+}
+
+func TestSourceLenIsBounded(t *testing.T) {
+	assert.Equal(t, 255%(MaxLen+1), NewSource([]byte{255}).Len())
+}
+
+type node struct {
+	Name     string
+	Next     *node
+	Children []int
+	hidden   int
+}
+
+func TestFill(t *testing.T) {
+	var n *node
+	NewSource([]byte{0}).Fill(&n)
+	assert.Nil(t, n)
+
+	NewSource([]byte{
+		1,           // n is not nil
+		2, 'h', 'i', // n.Name
+		1,       // n.Next is not nil
+		0, 0, 0, // n.Next.Name, n.Next.Next and n.Next.Children are empty
+		1, 0, 0, 0, 0, 0, 0, 0, 42, // n.Children
+	}).Fill(&n)
+	if assert.NotNil(t, n) {
+		assert.Equal(t, "hi", n.Name)
+		assert.Equal(t, &node{Children: []int{}}, n.Next)
+		assert.Equal(t, []int{42}, n.Children)
+		assert.Zero(t, n.hidden)
+	}
+}
+
+func TestFillError(t *testing.T) {
+	var err error
+	NewSource([]byte{1, 3}).Fill(&err)
+	assert.True(t, errors.Is(err, ErrFuzz))
+
+	var m map[string]bool
+	NewSource([]byte{1, 1, 'k', 1}).Fill(&m)
+	assert.Equal(t, map[string]bool{"k": true}, m)
+}
+
+func TestFillRecursiveTypeTerminates(t *testing.T) {
+	data := make([]byte, 1024)
+	for i := range data {
+		data[i] = 1
+	}
+	var n *node
+	NewSource(data).Fill(&n)
+	assert.NotNil(t, n)
+}
+
+func TestFillPanicsOnNonPointer(t *testing.T) {
+	assert.Panics(t, func() {
+		NewSource(nil).Fill(0)
+	})
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/vektra/mockery/v3/config"
+	"github.com/vektra/mockery/v3/internal/logging"
+)
+
+func NewConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and validate the mockery config file",
+	}
+	cmd.AddCommand(NewConfigValidateCmd())
+	cmd.AddCommand(NewConfigSchemaCmd())
+	return cmd
+}
+
+func NewConfigValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the mockery config file",
+		Long: `Check the mockery config file, and any files it includes, for unknown parameters,
+values of the wrong type and invalid regular expressions. Each problem is reported
+with the file, line and column where it was found.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, err := logging.GetLogger("info")
+			if err != nil {
+				return err
+			}
+			ctx := log.WithContext(context.Background())
+
+			configFile, err := config.ConfigFilePath(ctx, cmd.Root().PersistentFlags())
+			if err != nil {
+				return err
+			}
+			validationErrors, err := config.ValidateFile(configFile.String())
+			if err != nil {
+				return err
+			}
+			for _, validationErr := range validationErrors {
+				fmt.Fprintln(cmd.OutOrStdout(), validationErr.Error())
+			}
+			if len(validationErrors) != 0 {
+				return fmt.Errorf("found %d problem(s) in config", len(validationErrors))
+			}
+			log.Info().Str("config-file", configFile.String()).Msg("config is valid")
+			return nil
+		},
+	}
+}
+
+func NewConfigSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON schema of the mockery config file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, err := config.JSONSchema()
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(schema)
+			return err
+		},
+	}
+}
@@ -1,13 +1,24 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/chigopher/pathlib"
+	"github.com/huandu/xstrings"
+	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"github.com/vektra/mockery/v3/config"
+	pkg "github.com/vektra/mockery/v3/internal"
 	"github.com/vektra/mockery/v3/internal/logging"
 	"gopkg.in/yaml.v3"
 )
@@ -17,15 +28,38 @@ func addr[T any](v T) *T {
 }
 
 func NewInitCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "init [module_name]",
 		Short: "Generate a basic .mockery.yml file",
-		Long:  `This command generates a basic .mockery.yml file that can be used as a starting point for your config.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `This command generates a basic .mockery.yml file that can be used as a starting point for your config.
+
+With --scan, the given package patterns are loaded instead and every package that declares
+interfaces is listed in the config, along with its interfaces. Adding --infer looks for mocks
+previously generated by mockery and sets dir, filename, structname, pkgname and template to
+the patterns most commonly used by them.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			scan, err := cmd.Flags().GetStringSlice("scan")
+			if err != nil {
+				return err
+			}
+			if len(scan) != 0 {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		Run: func(cmd *cobra.Command, args []string) {
-			initRun(args, cmd.Parent().PersistentFlags())
+			scan, _ := cmd.Flags().GetStringSlice("scan")
+			if len(scan) == 0 {
+				initRun(args, cmd.Parent().PersistentFlags())
+				return
+			}
+			infer, _ := cmd.Flags().GetBool("infer")
+			initScanRun(scan, infer, cmd.Parent().PersistentFlags())
 		},
 	}
+	cmd.Flags().StringSlice("scan", nil, "package patterns to scan for interfaces, e.g. ./...")
+	cmd.Flags().Bool("infer", false, "infer dir, filename, structname, pkgname and template from mocks previously generated by mockery (requires --scan)")
+	return cmd
 }
 
 type argGetter interface {
@@ -38,28 +72,20 @@ func initRun(args []string, params argGetter) {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	filename, err := params.GetString("config")
+	filename, err := initConfigFilename(params)
 	if err != nil {
 		log.Err(err).Msg("failed to get --config value")
 		os.Exit(1)
 	}
-	if filename == "" {
-		filename = ".mockery.yml"
-	}
 
 	moduleName := args[0]
 	log.Info().Str("file", filename).Msg("writing to file")
 	defer log.Info().Msg("done")
 
 	ctx := log.WithContext(context.Background())
-	k, err := config.NewDefaultKoanf(ctx)
+	rootConf, err := defaultRootConfig(ctx)
 	if err != nil {
-		log.Err(err).Msg("failed getting koanf")
-		os.Exit(1)
-	}
-	rootConf := &config.RootConfig{}
-	if err := k.Unmarshal("", rootConf); err != nil {
-		log.Err(err).Msg("failed to unmarshal koanf")
+		log.Err(err).Msg("failed to get default config")
 		os.Exit(1)
 	}
 	rootConf.Packages = map[string]*config.PackageConfig{
@@ -71,11 +97,72 @@ func initRun(args []string, params argGetter) {
 		},
 	}
 
+	if err := writeInitConfig(filename, rootConf); err != nil {
+		log.Err(err).Msg("failed to write config")
+		os.Exit(1)
+	}
+}
+
+func initScanRun(patterns []string, infer bool, params argGetter) {
+	log, err := logging.GetLogger("info")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	filename, err := initConfigFilename(params)
+	if err != nil {
+		log.Err(err).Msg("failed to get --config value")
+		os.Exit(1)
+	}
+	ctx := log.WithContext(context.Background())
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		log.Err(err).Msg("failed to get working directory")
+		os.Exit(1)
+	}
+	rootConf, err := scanRootConfig(ctx, workingDir, patterns, infer)
+	if err != nil {
+		log.Err(err).Msg("failed to scan packages")
+		os.Exit(1)
+	}
+
+	log.Info().Str("file", filename).Int("packages", len(rootConf.Packages)).Msg("writing to file")
+	if err := writeInitConfig(filename, rootConf); err != nil {
+		log.Err(err).Msg("failed to write config")
+		os.Exit(1)
+	}
+	log.Info().Msg("done")
+}
+
+func initConfigFilename(params argGetter) (string, error) {
+	filename, err := params.GetString("config")
+	if err != nil {
+		return "", err
+	}
+	if filename == "" {
+		filename = ".mockery.yml"
+	}
+	return filename, nil
+}
+
+func defaultRootConfig(ctx context.Context) (*config.RootConfig, error) {
+	k, err := config.NewDefaultKoanf(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting koanf: %w", err)
+	}
+	rootConf := &config.RootConfig{}
+	if err := k.Unmarshal("", rootConf); err != nil {
+		return nil, fmt.Errorf("unmarshalling koanf: %w", err)
+	}
+	return rootConf, nil
+}
+
+func writeInitConfig(filename string, rootConf *config.RootConfig) error {
 	outFile := pathlib.NewPath(filename)
 	f, err := outFile.OpenFile(os.O_RDWR | os.O_CREATE | os.O_EXCL)
 	if err != nil {
-		log.Err(err).Msg("failed to open file")
-		os.Exit(1)
+		return fmt.Errorf("opening file: %w", err)
 	}
 	defer f.Close()
 
@@ -83,7 +170,299 @@ func initRun(args []string, params argGetter) {
 	defer encoder.Close()
 	encoder.SetIndent(2)
 	if err := encoder.Encode(rootConf); err != nil {
-		log.Err(err).Msg("failed to encode")
-		os.Exit(1)
+		return fmt.Errorf("encoding config: %w", err)
+	}
+	return nil
+}
+
+// scanRootConfig loads the packages matched by patterns and returns a config
+// that lists each of their interfaces explicitly. If infer is set, the
+// top-level parameters are set to the patterns used by the mocks previously
+// generated by mockery under rootDir.
+func scanRootConfig(ctx context.Context, rootDir string, patterns []string, infer bool) (*config.RootConfig, error) {
+	log := zerolog.Ctx(ctx)
+
+	rootConf, err := defaultRootConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	interfaces, err := pkg.NewParser(nil).ParsePackages(ctx, patterns)
+	if err != nil {
+		return nil, fmt.Errorf("parsing packages: %w", err)
+	}
+
+	// Mocks generated by mockery don't declare interfaces of their own, but
+	// guard against listing anything from them regardless.
+	generatedFiles := map[string]bool{}
+	scanned := []*config.Interface{}
+	for _, iface := range interfaces {
+		generated, seen := generatedFiles[iface.FileName]
+		if !seen {
+			_, generated, err = mockeryTemplate(pathlib.NewPath(iface.FileName))
+			if err != nil {
+				return nil, err
+			}
+			generatedFiles[iface.FileName] = generated
+		}
+		if generated {
+			continue
+		}
+		scanned = append(scanned, iface)
+	}
+
+	rootConf.Packages = map[string]*config.PackageConfig{}
+	for _, iface := range scanned {
+		pkgConfig, ok := rootConf.Packages[iface.Pkg.PkgPath]
+		if !ok {
+			pkgConfig = &config.PackageConfig{Interfaces: map[string]*config.InterfaceConfig{}}
+			rootConf.Packages[iface.Pkg.PkgPath] = pkgConfig
+		}
+		pkgConfig.Interfaces[iface.Name] = &config.InterfaceConfig{}
+	}
+	log.Info().Int("packages", len(rootConf.Packages)).Int("interfaces", len(scanned)).Msg("scanned packages")
+
+	if !infer {
+		return rootConf, nil
+	}
+	inferred, err := inferMockPatterns(ctx, rootDir, scanned)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range []struct {
+		name     string
+		inferred *string
+		target   **string
+	}{
+		{"dir", inferred.Dir, &rootConf.Dir},
+		{"filename", inferred.FileName, &rootConf.FileName},
+		{"structname", inferred.StructName, &rootConf.StructName},
+		{"pkgname", inferred.PkgName, &rootConf.PkgName},
+		{"template", inferred.Template, &rootConf.Template},
+	} {
+		if field.inferred == nil {
+			continue
+		}
+		log.Info().Str("parameter", field.name).Str("value", *field.inferred).Msg("inferred parameter from existing mocks")
+		*field.target = field.inferred
+	}
+	return rootConf, nil
+}
+
+// mockeryTemplate reports whether the file at path was generated by mockery
+// and, if the file's header records it, the name of the template used.
+func mockeryTemplate(path *pathlib.Path) (string, bool, error) {
+	generated, err := config.IsAutoGenerated(path)
+	if err != nil || !generated {
+		return "", false, err
+	}
+	b, err := path.ReadFile()
+	if err != nil {
+		return "", false, fmt.Errorf("reading %s: %w", path, err)
+	}
+	header, _, _ := bytes.Cut(b, []byte("\npackage "))
+	if !bytes.Contains(header, []byte("Code generated by mockery")) {
+		return "", false, nil
+	}
+	for _, line := range strings.Split(string(header), "\n") {
+		if templateName, ok := strings.CutPrefix(line, "// template: "); ok {
+			return strings.TrimSpace(templateName), true, nil
+		}
+	}
+	return "", true, nil
+}
+
+// votes counts how often each value of a parameter was observed.
+type votes map[string]int
+
+// winner returns the most common value, or nil if nothing was observed. Ties
+// are broken alphabetically so that the result is deterministic.
+func (v votes) winner() *string {
+	var best string
+	bestCount := 0
+	for value, count := range v {
+		if count > bestCount || (count == bestCount && value < best) {
+			best, bestCount = value, count
+		}
+	}
+	if bestCount == 0 {
+		return nil
+	}
+	return &best
+}
+
+// inferMockPatterns walks rootDir for files generated by mockery, matches the
+// mock structs in them to the given interfaces and returns a config holding
+// the dir, filename, structname, pkgname and template patterns that explain
+// the most mocks. Parameters without any observation are left nil.
+func inferMockPatterns(ctx context.Context, rootDir string, interfaces []*config.Interface) (*config.Config, error) {
+	log := zerolog.Ctx(ctx)
+
+	byName := map[string][]*config.Interface{}
+	for _, iface := range interfaces {
+		byName[iface.Name] = append(byName[iface.Name], iface)
+	}
+	for _, candidates := range byName {
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].Pkg.PkgPath < candidates[j].Pkg.PkgPath
+		})
+	}
+
+	dirVotes, fileNameVotes, structNameVotes, pkgNameVotes, templateVotes := votes{}, votes{}, votes{}, votes{}, votes{}
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != rootDir && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		templateName, generated, err := mockeryTemplate(pathlib.NewPath(path))
+		if err != nil {
+			return err
+		}
+		if !generated {
+			return nil
+		}
+		fileLog := log.With().Str("file", path).Logger()
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			fileLog.Warn().Err(err).Msg("failed to parse generated file, skipping")
+			return nil
+		}
+		mockDir := filepath.Dir(path)
+		for _, structName := range declaredStructs(file) {
+			iface, prefix := matchMockStruct(structName, mockDir, byName)
+			if iface == nil {
+				continue
+			}
+			fileLog.Debug().Str("struct", structName).Str("interface", iface.Name).Msg("found existing mock")
+			dirVotes[inferDir(rootDir, filepath.Dir(iface.FileName), mockDir, iface.Pkg.PkgPath)]++
+			fileNameVotes[inferFileName(filepath.Base(path), iface.Name)]++
+			structNameVotes[inferStructName(prefix, iface.Name)]++
+			pkgNameVotes[inferPkgName(file.Name.Name, iface.Pkg.Name)]++
+			if templateName != "" {
+				templateVotes[templateName]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("looking for existing mocks: %w", err)
+	}
+
+	return &config.Config{
+		Dir:        dirVotes.winner(),
+		FileName:   fileNameVotes.winner(),
+		StructName: structNameVotes.winner(),
+		PkgName:    pkgNameVotes.winner(),
+		Template:   templateVotes.winner(),
+	}, nil
+}
+
+func declaredStructs(file *ast.File) []string {
+	structs := []string{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, isStruct := typeSpec.Type.(*ast.StructType); isStruct {
+				structs = append(structs, typeSpec.Name.Name)
+			}
+		}
+	}
+	return structs
+}
+
+// matchMockStruct finds the interface mocked by structName, along with the
+// prefix added to the interface name. The longest matching interface name
+// wins. Helper types like MockFoo_Expecter are skipped by rejecting prefixes
+// that contain an underscore. Among interfaces of the same name, the one
+// closest to the mock's directory is preferred.
+func matchMockStruct(structName string, mockDir string, byName map[string][]*config.Interface) (*config.Interface, string) {
+	var bestName string
+	for name := range byName {
+		prefix, ok := strings.CutSuffix(structName, name)
+		if !ok || strings.Contains(prefix, "_") {
+			continue
+		}
+		if len(name) > len(bestName) {
+			bestName = name
+		}
+	}
+	if bestName == "" {
+		return nil, ""
+	}
+	prefix := strings.TrimSuffix(structName, bestName)
+	candidates := byName[bestName]
+	for _, iface := range candidates {
+		if filepath.Dir(iface.FileName) == mockDir {
+			return iface, prefix
+		}
+	}
+	for _, iface := range candidates {
+		if rel, err := filepath.Rel(filepath.Dir(iface.FileName), mockDir); err == nil && !strings.HasPrefix(rel, "..") {
+			return iface, prefix
+		}
+	}
+	return candidates[0], prefix
+}
+
+func inferDir(rootDir string, ifaceDir string, mockDir string, pkgPath string) string {
+	if rel, err := filepath.Rel(ifaceDir, mockDir); err == nil && !strings.HasPrefix(rel, "..") {
+		if rel == "." {
+			return "{{.InterfaceDir}}"
+		}
+		return "{{.InterfaceDir}}/" + filepath.ToSlash(rel)
+	}
+	mockRel, err := filepath.Rel(rootDir, mockDir)
+	if err != nil {
+		return filepath.ToSlash(mockDir)
+	}
+	mockRel = filepath.ToSlash(mockRel)
+	if base, ok := strings.CutSuffix(mockRel, "/"+pkgPath); ok {
+		return base + "/{{.SrcPackagePath}}"
+	}
+	if ifaceRel, err := filepath.Rel(rootDir, ifaceDir); err == nil {
+		if base, ok := strings.CutSuffix(mockRel, "/"+filepath.ToSlash(ifaceRel)); ok {
+			return base + "/{{.InterfaceDirRelative}}"
+		}
+	}
+	return mockRel
+}
+
+func inferFileName(fileName string, ifaceName string) string {
+	if strings.Contains(fileName, ifaceName) {
+		return strings.Replace(fileName, ifaceName, "{{.InterfaceName}}", 1)
+	}
+	if snake := xstrings.ToSnakeCase(ifaceName); strings.Contains(fileName, snake) {
+		return strings.Replace(fileName, snake, "{{.InterfaceName | snakecase}}", 1)
+	}
+	if lower := strings.ToLower(ifaceName); strings.Contains(fileName, lower) {
+		return strings.Replace(fileName, lower, "{{.InterfaceName | lower}}", 1)
+	}
+	return fileName
+}
+
+func inferStructName(prefix string, ifaceName string) string {
+	if (prefix == "Mock" && ast.IsExported(ifaceName)) || (prefix == "mock" && !ast.IsExported(ifaceName)) {
+		return "{{.Mock}}{{.InterfaceName}}"
+	}
+	return prefix + "{{.InterfaceName}}"
+}
+
+func inferPkgName(mockPkgName string, srcPkgName string) string {
+	if srcPkgName != "" && strings.Contains(mockPkgName, srcPkgName) {
+		return strings.Replace(mockPkgName, srcPkgName, "{{.SrcPackageName}}", 1)
 	}
+	return mockPkgName
 }
@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/chigopher/pathlib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektra/mockery/v3/config"
+	"golang.org/x/tools/go/packages"
 )
 
 var expectedConfig string = `all: false
@@ -61,3 +65,66 @@ func Test_initRun(t *testing.T) {
 		})
 	}
 }
+
+func Test_inferMockPatterns(t *testing.T) {
+	rootDir := t.TempDir()
+	writeFile := func(path string, contents string) {
+		p := pathlib.NewPath(rootDir).Join(strings.Split(path, "/")...)
+		require.NoError(t, p.Parent().MkdirAll())
+		require.NoError(t, p.WriteFile([]byte(contents)))
+	}
+	mockFile := func(pkgName string, structs ...string) string {
+		contents := "// Code generated by mockery; DO NOT EDIT.\n// github.com/vektra/mockery\n// template: testify\n\npackage " + pkgName + "\n"
+		for _, s := range structs {
+			contents += "\ntype " + s + " struct{}\n"
+		}
+		return contents
+	}
+	writeFile("store/mocks/mock_Store.go", mockFile("mocks", "MockStore", "MockStore_Expecter", "MockStore_Get_Call"))
+	writeFile("cache/mocks/mock_Cache.go", mockFile("mocks", "MockCache", "MockCache_Expecter"))
+	writeFile("cache/mocks/mock_getter.go", mockFile("mocks", "mockgetter"))
+	writeFile("cache/handwritten.go", "package cache\n\ntype MockCache struct{}\n")
+
+	newInterface := func(name string, dir string, pkgName string) *config.Interface {
+		return config.NewInterface(
+			name,
+			filepath.Join(rootDir, dir, "iface.go"),
+			nil,
+			&packages.Package{Name: pkgName, PkgPath: "github.com/org/repo/" + dir},
+			nil,
+		)
+	}
+	interfaces := []*config.Interface{
+		newInterface("Store", "store", "store"),
+		newInterface("Cache", "cache", "cache"),
+		newInterface("getter", "cache", "cache"),
+	}
+
+	inferred, err := inferMockPatterns(context.Background(), rootDir, interfaces)
+	require.NoError(t, err)
+	assert.Equal(t, "{{.InterfaceDir}}/mocks", *inferred.Dir)
+	assert.Equal(t, "mock_{{.InterfaceName}}.go", *inferred.FileName)
+	assert.Equal(t, "{{.Mock}}{{.InterfaceName}}", *inferred.StructName)
+	assert.Equal(t, "mocks", *inferred.PkgName)
+	assert.Equal(t, "testify", *inferred.Template)
+}
+
+func Test_inferDir(t *testing.T) {
+	tests := []struct {
+		name     string
+		ifaceDir string
+		mockDir  string
+		want     string
+	}{
+		{name: "same dir", ifaceDir: "/repo/store", mockDir: "/repo/store", want: "{{.InterfaceDir}}"},
+		{name: "subdir", ifaceDir: "/repo/store", mockDir: "/repo/store/mocks", want: "{{.InterfaceDir}}/mocks"},
+		{name: "mirrored tree", ifaceDir: "/repo/internal/store", mockDir: "/repo/mocks/internal/store", want: "mocks/{{.InterfaceDirRelative}}"},
+		{name: "package path", ifaceDir: "/repo/internal/store", mockDir: "/repo/mocks/github.com/org/repo/internal/store", want: "mocks/{{.SrcPackagePath}}"},
+		{name: "shared dir", ifaceDir: "/repo/internal/store", mockDir: "/repo/mocks", want: "mocks"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inferDir("/repo", tt.ifaceDir, tt.mockDir, "github.com/org/repo/internal/store"))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/vektra/mockery/v3/internal/logging"
+)
+
+func NewListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the interfaces found in the configured packages",
+		Long: `Parse the configured packages and list every interface found in them. For each
+interface, the output shows whether the config selects it for generation and why, and
+for each mock that would be generated, its struct name, template and output file.
+Nothing is rendered or written.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if output != "table" && output != "json" {
+				return fmt.Errorf("unknown output format %q, must be one of: table, json", output)
+			}
+			log, err := logging.GetLogger("warn")
+			if err != nil {
+				return err
+			}
+			ctx := log.WithContext(context.Background())
+
+			entries, err := listInterfaces(ctx, cmd)
+			if err != nil {
+				return err
+			}
+			if output == "json" {
+				return writeListJSON(cmd.OutOrStdout(), entries)
+			}
+			writeListTable(cmd.OutOrStdout(), entries)
+			return nil
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "output format, one of: table, json")
+	return cmd
+}
+
+// listMock describes a single mock that would be generated for an interface.
+type listMock struct {
+	StructName string `json:"structname"`
+	Template   string `json:"template"`
+	OutputFile string `json:"output-file"`
+}
+
+// listEntry describes an interface found in one of the configured packages.
+type listEntry struct {
+	Package   string     `json:"package"`
+	Interface string     `json:"interface"`
+	Selected  bool       `json:"selected"`
+	Reason    string     `json:"reason"`
+	Mocks     []listMock `json:"mocks"`
+}
+
+func listInterfaces(ctx context.Context, cmd *cobra.Command) ([]listEntry, error) {
+	log := zerolog.Ctx(ctx)
+
+	r, err := GetRootApp(ctx, cmd.Root().PersistentFlags())
+	if err != nil {
+		return nil, err
+	}
+	interfaces, missingMap, err := r.resolveInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for pkgPath, ifaceNames := range missingMap {
+		for ifaceName := range ifaceNames {
+			log.Warn().
+				Str(logging.LogKeyInterface, ifaceName).
+				Str(logging.LogKeyPackagePath, pkgPath).
+				Msg("interface not found in source")
+		}
+	}
+
+	entries := make([]listEntry, 0, len(interfaces))
+	for _, iface := range interfaces {
+		entry := listEntry{
+			Package:   iface.iface.Pkg.PkgPath,
+			Interface: iface.iface.Name,
+			Selected:  iface.selected,
+			Reason:    string(iface.reason),
+			Mocks:     []listMock{},
+		}
+		for _, mock := range iface.mocks {
+			entry.Mocks = append(entry.Mocks, listMock{
+				StructName: *mock.iface.Config.StructName,
+				Template:   *mock.iface.Config.Template,
+				OutputFile: mock.iface.Config.FilePath().String(),
+			})
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Package != entries[j].Package {
+			return entries[i].Package < entries[j].Package
+		}
+		return entries[i].Interface < entries[j].Interface
+	})
+	return entries, nil
+}
+
+func writeListJSON(w io.Writer, entries []listEntry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(entries); err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+	return nil
+}
+
+func writeListTable(w io.Writer, entries []listEntry) {
+	tbl := table.NewWriter()
+	tbl.SetOutputMirror(w)
+	tbl.Style().Box = table.StyleBoxRounded
+	tbl.Style().Options.SeparateColumns = false
+
+	tbl.AppendHeader(table.Row{
+		"Package",
+		"Interface",
+		"Selected",
+		"Reason",
+		"Struct Name",
+		"Template",
+		"Output File",
+	})
+	for _, entry := range entries {
+		if len(entry.Mocks) == 0 {
+			tbl.AppendRow(table.Row{entry.Package, entry.Interface, entry.Selected, entry.Reason, "", "", ""})
+			continue
+		}
+		for _, mock := range entry.Mocks {
+			tbl.AppendRow(table.Row{
+				entry.Package,
+				entry.Interface,
+				entry.Selected,
+				entry.Reason,
+				mock.StructName,
+				mock.Template,
+				mock.OutputFile,
+			})
+		}
+	}
+	tbl.Render()
+}
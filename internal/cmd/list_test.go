@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/chigopher/pathlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runList(t *testing.T, configContents string, args ...string) (string, string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	outDir := filepath.Join(tmpDir, "mocks")
+	configPath := pathlib.NewPath(tmpDir).Join(".mockery.yml")
+	require.NoError(t, configPath.WriteFile([]byte("dir: "+outDir+"\n"+configContents)))
+
+	root, err := NewRootCmd()
+	require.NoError(t, err)
+	var stdout bytes.Buffer
+	root.SetOut(&stdout)
+	root.SetArgs(append([]string{"list", "--config", configPath.String()}, args...))
+	require.NoError(t, root.Execute())
+
+	exists, err := pathlib.NewPath(outDir).Exists()
+	require.NoError(t, err)
+	assert.False(t, exists, "list must not write any file")
+	return stdout.String(), outDir
+}
+
+const listConfig = `filename: mocks_test.go
+structname: '{{.Mock}}{{.InterfaceName}}'
+template: testify
+packages:
+  github.com/vektra/mockery/v3/internal/fixtures/method_args/ret_names:
+    config:
+      all: true
+  github.com/vektra/mockery/v3/internal/fixtures/method_args/same_name_arg_and_type:
+    config:
+      include-interface-regex: '^interfaceB0$'
+      template: matryer
+      structname: 'Moq{{.InterfaceName}}'
+    interfaces:
+      interfaceA:
+`
+
+func TestListJSON(t *testing.T) {
+	out, outDir := runList(t, listConfig, "--output", "json")
+
+	var entries []listEntry
+	require.NoError(t, json.Unmarshal([]byte(out), &entries))
+	assert.Equal(t, []listEntry{
+		{
+			Package:   "github.com/vektra/mockery/v3/internal/fixtures/method_args/ret_names",
+			Interface: "RetNames",
+			Selected:  true,
+			Reason:    "all",
+			Mocks: []listMock{{
+				StructName: "MockRetNames",
+				Template:   "testify",
+				OutputFile: filepath.Join(outDir, "mocks_test.go"),
+			}},
+		},
+		{
+			Package:   "github.com/vektra/mockery/v3/internal/fixtures/method_args/same_name_arg_and_type",
+			Interface: "interfaceA",
+			Selected:  true,
+			Reason:    "explicit",
+			Mocks: []listMock{{
+				StructName: "MoqinterfaceA",
+				Template:   "matryer",
+				OutputFile: filepath.Join(outDir, "mocks_test.go"),
+			}},
+		},
+		{
+			Package:   "github.com/vektra/mockery/v3/internal/fixtures/method_args/same_name_arg_and_type",
+			Interface: "interfaceB",
+			Selected:  false,
+			Reason:    "not-selected",
+			Mocks:     []listMock{},
+		},
+		{
+			Package:   "github.com/vektra/mockery/v3/internal/fixtures/method_args/same_name_arg_and_type",
+			Interface: "interfaceB0",
+			Selected:  true,
+			Reason:    "include-interface-regex",
+			Mocks: []listMock{{
+				StructName: "MoqinterfaceB0",
+				Template:   "matryer",
+				OutputFile: filepath.Join(outDir, "mocks_test.go"),
+			}},
+		},
+	}, entries)
+}
+
+func TestListTable(t *testing.T) {
+	out, outDir := runList(t, listConfig)
+
+	for _, want := range []string{
+		"PACKAGE", "INTERFACE", "SELECTED", "REASON", "STRUCT NAME", "TEMPLATE", "OUTPUT FILE",
+		"RetNames", "MockRetNames", "testify",
+		"interfaceB0", "include-interface-regex", "MoqinterfaceB0", "matryer",
+		"explicit", "not-selected",
+		filepath.Join(outDir, "mocks_test.go"),
+	} {
+		assert.Contains(t, out, want)
+	}
+}
+
+func TestListUnknownOutput(t *testing.T) {
+	root, err := NewRootCmd()
+	require.NoError(t, err)
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs([]string{"list", "--output", "yaml"})
+	assert.EqualError(t, root.Execute(), `unknown output format "yaml", must be one of: table, json`)
+}
@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/chigopher/pathlib"
@@ -12,7 +14,6 @@ import (
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
-	"github.com/vektra/mockery/v3/config"
 	internalConfig "github.com/vektra/mockery/v3/internal/config"
 	"github.com/vektra/mockery/v3/internal/logging"
 	"golang.org/x/term"
@@ -21,9 +22,14 @@ import (
 
 func NewMigrateCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "migrate",
+		Use:   "migrate [dir...]",
 		Short: "Migrate v2 config to v3.",
-		Long:  `This command automatically migrates a v2 config to v3.`,
+		Long: `This command automatically migrates a v2 config to v3. Deprecated parameters are renamed,
+moved or removed, while comments, anchors and the order of keys are preserved.
+
+With --from mockgen or --from counterfeiter, the given directories (by default the current
+directory) are instead scanned for the generator's directives, which are converted into a v3
+config.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			logLevel, err := cmd.Flags().GetString("log-level")
 			if err != nil {
@@ -50,12 +56,37 @@ func NewMigrateCmd() *cobra.Command {
 				log.Err(err).Msg("failed to get parameter")
 				os.Exit(1)
 			}
+			from, err := cmd.Flags().GetString("from")
+			if err != nil {
+				log.Err(err).Msg("failed to get parameter")
+				os.Exit(1)
+			}
+			inPlace, err := cmd.Flags().GetBool("in-place")
+			if err != nil {
+				log.Err(err).Msg("failed to get parameter")
+				os.Exit(1)
+			}
+			if inPlace && from != "v2" {
+				log.Error().Msg("--in-place can only be used with --from v2")
+				os.Exit(1)
+			}
 
-			if err := run(
-				ctx,
-				v2ConfPath,
-				v3ConfigPath,
-			); err != nil {
+			switch from {
+			case "v2":
+				err = run(
+					ctx,
+					v2ConfPath,
+					v3ConfigPath,
+					inPlace,
+				)
+			case "mockgen":
+				err = runGeneratorMigration(ctx, args, v3ConfigPath, runMockgenMigration)
+			case "counterfeiter":
+				err = runGeneratorMigration(ctx, args, v3ConfigPath, runCounterfeiterMigration)
+			default:
+				err = fmt.Errorf("unknown value for --from: %q", from)
+			}
+			if err != nil {
 				log.Err(err).Msg("failed to run")
 				fmt.Printf("%v\n", err)
 				os.Exit(1)
@@ -64,6 +95,8 @@ func NewMigrateCmd() *cobra.Command {
 	}
 	flags := cmd.PersistentFlags()
 	flags.String("outfile", ".mockery_v3.yml", "Location of the ouptut v3 file.")
+	flags.String("from", "v2", "What to migrate from. One of: v2, mockgen, counterfeiter.")
+	flags.Bool("in-place", false, "Overwrite the v2 config with the migrated config, keeping a copy of the original in <config>.bak.")
 
 	return cmd
 }
@@ -128,7 +161,7 @@ func (t *tableWriter) Render() {
 	t.tbl.Render()
 }
 
-func run(ctx context.Context, confPathStr string, v3ConfPath string) error {
+func run(ctx context.Context, confPathStr string, v3ConfPath string, inPlace bool) error {
 	var confPath *pathlib.Path
 	var err error
 
@@ -146,73 +179,62 @@ func run(ctx context.Context, confPathStr string, v3ConfPath string) error {
 	})
 	log.Info().Msg("using config")
 
-	var v2 V2RootConfig
-	f, err := confPath.OpenFile(os.O_RDONLY)
+	v2Bytes, err := confPath.ReadFile()
 	if err != nil {
-		return fmt.Errorf("opening config file: %w", err)
+		return fmt.Errorf("reading config file: %w", err)
 	}
-	decoder := yaml.NewDecoder(f)
+	// Decoding into the v2 schema catches unknown parameters, which would
+	// otherwise be carried over to the v3 config untouched.
+	var v2 V2RootConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(v2Bytes))
 	decoder.KnownFields(true)
 	if err := decoder.Decode(&v2); err != nil {
 		log.Error().Msg("v2 config could not be decoded. Are you sure this is a v2 config file?")
 		return fmt.Errorf("decoding v2 config: %w", err)
 	}
 
-	var v3 config.RootConfig
-	var v3Config *config.Config = &config.Config{}
-	v3.TemplateData = map[string]any{}
+	var document yaml.Node
+	if err := yaml.Unmarshal(v2Bytes, &document); err != nil {
+		return fmt.Errorf("parsing v2 config: %w", err)
+	}
+	if len(document.Content) == 0 || resolveYAMLAlias(document.Content[0]).Kind != yaml.MappingNode {
+		return fmt.Errorf("v2 config is not a map")
+	}
 
 	tbl := newTableWriter(ctx)
+	m := &nodeMigrator{tbl: tbl, visited: map[*yaml.Node]bool{}}
+	m.migrateRoot(ctx, document.Content[0])
 
-	migrateConfig(ctx, tbl, &v2.V2Config, &v3Config)
-	v3Config.Template = addr("testify")
-	v3.Config = *v3Config
-
-	for pkgName, pkgConfig := range v2.Packages {
-		pkgLog := log.With().Str("pkg-name", pkgName).Logger()
-		pkgCtx := pkgLog.WithContext(ctx)
-
-		v3PkgConfig := &config.PackageConfig{}
-		if v3.Packages == nil {
-			v3.Packages = map[string]*config.PackageConfig{}
-		}
-		v3.Packages[pkgName] = v3PkgConfig
-		migrateConfig(pkgCtx, tbl, pkgConfig.Config, &v3PkgConfig.Config)
-
-		for interfaceName, interfaceConfig := range pkgConfig.Interfaces {
-			ifaceLog := pkgLog.With().Str("interface-name", interfaceName).Logger()
-			ifaceCtx := ifaceLog.WithContext(pkgCtx)
-
-			v3InterfaceConfig := config.InterfaceConfig{}
-			if v3PkgConfig.Interfaces == nil {
-				v3PkgConfig.Interfaces = map[string]*config.InterfaceConfig{}
-			}
-			v3PkgConfig.Interfaces[interfaceName] = &v3InterfaceConfig
-
-			migrateConfig(ifaceCtx, tbl, interfaceConfig.Config, &v3InterfaceConfig.Config)
-
-			for _, v2SubConfig := range interfaceConfig.Configs {
-				v3SubConfig := &config.Config{}
-				v3InterfaceConfig.Configs = append(v3InterfaceConfig.Configs, v3SubConfig)
-				migrateConfig(ifaceCtx, tbl, &v2SubConfig, &v3SubConfig)
-			}
-		}
+	var v3Bytes bytes.Buffer
+	encoder := yaml.NewEncoder(&v3Bytes)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return fmt.Errorf("encoding v3 config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("encoding v3 config: %w", err)
 	}
 
 	outFile := pathlib.NewPath(v3ConfPath)
-	file, err := outFile.OpenFile(os.O_CREATE | os.O_RDWR | os.O_TRUNC)
-	if err != nil {
-		return fmt.Errorf("opening .mockery_v3.yml: %w", err)
+	if inPlace {
+		outFile = confPath
+		backup := pathlib.NewPath(confPath.String() + ".bak")
+		backupFile, err := backup.OpenFile(os.O_CREATE | os.O_WRONLY | os.O_EXCL)
+		if err != nil {
+			return fmt.Errorf("creating backup file: %w", err)
+		}
+		_, err = backupFile.Write(v2Bytes)
+		if closeErr := backupFile.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("writing backup file: %w", err)
+		}
+		log.Info().Stringer("backup", backup).Msg("backed up v2 config")
 	}
-	defer file.Close()
-
-	encoder := yaml.NewEncoder(file)
-	defer encoder.Close()
-	encoder.SetIndent(2)
-
 	log.Info().Str("v3-config", outFile.String()).Msg("writing v3 config")
-	if err := encoder.Encode(v3); err != nil {
-		return fmt.Errorf("encoding .mockery_v3.yml: %w", err)
+	if err := outFile.WriteFile(v3Bytes.Bytes()); err != nil {
+		return fmt.Errorf("writing v3 config: %w", err)
 	}
 
 	if len(tbl.seenMessages) != 0 {
@@ -275,44 +297,24 @@ func checkDeprecatedTemplateVariables(
 	}
 }
 
-func migrateConfig(
+// reportV2Deprecations adds a row to tbl for each parameter of v2Config that
+// can't be carried over to v3 as-is.
+func reportV2Deprecations(
 	ctx context.Context,
 	tbl *tableWriter,
 	v2Config *V2Config,
-	v3Config **config.Config,
 ) {
-	if v2Config == nil {
-		return
-	}
 	checkDeprecatedTemplateVariables(ctx, v2Config, tbl)
 
-	// We do this so we can lazily create a new `config` section if necessary.
-	// It's kind of gross, but the double pointer is necessary to update the struct
-	// that contains the *config.Config pointer.
-	if *v3Config == nil {
-		*v3Config = &config.Config{}
-	}
-	v3 := *v3Config
-	v3.All = v2Config.All
-	v3.Anchors = v2Config.Anchors
-	if v2Config.BoilerplateFile != nil {
-		if v3.TemplateData == nil {
-			v3.TemplateData = map[string]any{}
-		}
-		v3.TemplateData["boilerplate-file"] = v2Config.BoilerplateFile
-	}
-
 	if v2Config.BuildTags != nil {
 		tbl.Append("deprecated-parameter", "`tags` is no longer supported, parameter not migrated. Use `template-data.mock-build-tags` instead.")
 	}
 	if v2Config.Case != nil {
 		tbl.Append("deprecated-parameter", "`case` is no longer supported. Use `structname` to specify the name and exported-ness of the output mocks.")
 	}
-	v3.ConfigFile = v2Config.Config
 	if v2Config.Cpuprofile != nil {
 		tbl.Append("deprecated-parameter", "`cpuprofile` is not supported in v3, however we welcome PRs to implement the feature: https://github.com/vektra/mockery/issues/956")
 	}
-	v3.Dir = v2Config.Dir
 	if v2Config.DisableConfigSearch != nil {
 		tbl.Append("deprecated-parameter", "`disable-config-search` is permanently disabled in v3.")
 	}
@@ -327,8 +329,6 @@ func migrateConfig(
 	if v2Config.DryRun != nil && *v2Config.DryRun {
 		tbl.Append("deprecated-parameter", "`dry-run` not supported in v3.")
 	}
-	v3.ExcludeSubpkgRegex = v2Config.Exclude
-	v3.ExcludeInterfaceRegex = v2Config.ExcludeRegex
 	if v2Config.Exported != nil {
 		tbl.Append("deprecated-parameter", "`exported` is no longer supported. Use `structname` instead.")
 	}
@@ -342,28 +342,18 @@ func migrateConfig(
 	if v2Config.IncludeAutoGenerated != nil && *v2Config.IncludeAutoGenerated == false {
 		tbl.Append("deprecated-parameter", "`include-auto-generated` is not supported in v3, but PRs are welcome: https://github.com/vektra/mockery/issues/954")
 	}
-	v3.IncludeInterfaceRegex = v2Config.IncludeRegex
 	if v2Config.Issue845Fix == nil || *v2Config.Issue845Fix == false {
 		tbl.Append("deprecated-parameter", "`issue-845-fix` is permanently set to True in v3.")
 	}
 	if v2Config.KeepTree != nil && *v2Config.KeepTree == true {
 		tbl.Append("deprecated-parameter", "`keeptree` is not supported in v3. Use `dir` to specify where interfaces are located.")
 	}
-	v3.LogLevel = v2Config.LogLevel
-	if v2Config.MockBuildTags != nil {
-		if v3.TemplateData == nil {
-			v3.TemplateData = map[string]any{}
-		}
-		v3.TemplateData["mock-build-tags"] = *v2Config.MockBuildTags
-	}
-	v3.StructName = v2Config.MockName
 	if v2Config.Name != nil {
 		tbl.Append("deprecated-parameter", "`name` is no longer supported. Use `structname` instead.")
 	}
 	if v2Config.Note != nil {
 		tbl.Append("deprecated-parameter", "`note` is no longer supported.")
 	}
-	v3.PkgName = v2Config.Outpkg
 	if v2Config.Output != nil {
 		tbl.Append("deprecated-parameter", "`output` was replaced by `dir` in v2. This value is ignored.")
 	}
@@ -379,7 +369,6 @@ func migrateConfig(
 	if v2Config.Quiet != nil && *v2Config.Quiet == true {
 		tbl.Append("deprecated-parameter", "`quiet` is not supported in v3. Use `log-level` instead.")
 	}
-	v3.Recursive = v2Config.Recursive
 	if len(v2Config.ReplaceType) != 0 {
 		tbl.Append("deprecated-parameter", "`replace-type` has moved to a new schema. Cannot automatically migrate. Please visit https://vektra.github.io/mockery/latest-v3/replace-type/ for more information.")
 	}
@@ -395,18 +384,240 @@ func migrateConfig(
 	if v2Config.TestOnly != nil {
 		tbl.Append("deprecated-parameter", "`testonly` was replaced by `filename` in v2. This value is ignored and not supported in v3.")
 	}
-	if v2Config.UnrollVariadic != nil {
-		if v3.TemplateData == nil {
-			v3.TemplateData = map[string]any{}
+}
+
+// v2RenamedParameters maps the v2 parameters that were renamed in v3 to their
+// new names.
+var v2RenamedParameters = map[string]string{
+	"exclude":       "exclude-subpkg-regex",
+	"exclude-regex": "exclude-interface-regex",
+	"include-regex": "include-interface-regex",
+	"mockname":      "structname",
+	"outpkg":        "pkgname",
+}
+
+// v2TemplateDataParameters are the v2 parameters that moved to `template-data`
+// in v3.
+var v2TemplateDataParameters = map[string]bool{
+	"boilerplate-file": true,
+	"mock-build-tags":  true,
+	"unroll-variadic":  true,
+	"with-expecter":    true,
+}
+
+// v2RemovedParameters are the v2 parameters that don't exist in v3. The
+// reasons are reported by reportV2Deprecations.
+var v2RemovedParameters = map[string]bool{
+	"case":                          true,
+	"cpuprofile":                    true,
+	"disable-config-search":         true,
+	"disable-deprecation-warnings":  true,
+	"disable-func-mocks":            true,
+	"disable-version-string":        true,
+	"disabled-deprecation-warnings": true,
+	"dry-run":                       true,
+	"exported":                      true,
+	"fail-on-missing":               true,
+	"include-auto-generated":        true,
+	"inpackage":                     true,
+	"inpackage-suffix":              true,
+	"issue-845-fix":                 true,
+	"keeptree":                      true,
+	"name":                          true,
+	"note":                          true,
+	"output":                        true,
+	"packageprefix":                 true,
+	"print":                         true,
+	"profile":                       true,
+	"quiet":                         true,
+	"replace-type":                  true,
+	"resolve-type-alias":            true,
+	"srcpkg":                        true,
+	"structname":                    true,
+	"testonly":                      true,
+	"tags":                          true,
+	"version":                       true,
+}
+
+func resolveYAMLAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// nodeMigrator rewrites a v2 config in place on its yaml.Node tree. Only the
+// deprecated parameters are renamed, moved or removed, so comments, anchors,
+// aliases and the order of keys are preserved.
+type nodeMigrator struct {
+	tbl *tableWriter
+	// visited holds the config nodes already migrated. Anchored nodes can be
+	// reached through several aliases but must only be migrated once.
+	visited map[*yaml.Node]bool
+}
+
+func (m *nodeMigrator) migrateRoot(ctx context.Context, root *yaml.Node) {
+	root = resolveYAMLAlias(root)
+	m.migrateConfig(ctx, root, true)
+
+	hasTemplate := false
+	packagesIdx := len(root.Content)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		switch root.Content[i].Value {
+		case "template":
+			hasTemplate = true
+		case "packages":
+			packagesIdx = i
+			m.migratePackages(ctx, root.Content[i+1])
+		}
+	}
+	if !hasTemplate {
+		root.Content = slices.Insert(root.Content, packagesIdx,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "template"},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "testify"},
+		)
+	}
+}
+
+func (m *nodeMigrator) migratePackages(ctx context.Context, packages *yaml.Node) {
+	log := zerolog.Ctx(ctx)
+	packages = resolveYAMLAlias(packages)
+	if packages.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(packages.Content); i += 2 {
+		pkgLog := log.With().Str("pkg-name", packages.Content[i].Value).Logger()
+		pkgCtx := pkgLog.WithContext(ctx)
+
+		pkg := resolveYAMLAlias(packages.Content[i+1])
+		if pkg.Kind != yaml.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(pkg.Content); j += 2 {
+			switch pkg.Content[j].Value {
+			case "config":
+				m.migrateConfig(pkgCtx, pkg.Content[j+1], false)
+			case "interfaces":
+				m.migrateInterfaces(pkgCtx, pkg.Content[j+1])
+			}
+		}
+	}
+}
+
+func (m *nodeMigrator) migrateInterfaces(ctx context.Context, interfaces *yaml.Node) {
+	log := zerolog.Ctx(ctx)
+	interfaces = resolveYAMLAlias(interfaces)
+	if interfaces.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(interfaces.Content); i += 2 {
+		ifaceLog := log.With().Str("interface-name", interfaces.Content[i].Value).Logger()
+		ifaceCtx := ifaceLog.WithContext(ctx)
+
+		iface := resolveYAMLAlias(interfaces.Content[i+1])
+		if iface.Kind != yaml.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(iface.Content); j += 2 {
+			switch iface.Content[j].Value {
+			case "config":
+				m.migrateConfig(ifaceCtx, iface.Content[j+1], false)
+			case "configs":
+				configs := resolveYAMLAlias(iface.Content[j+1])
+				if configs.Kind != yaml.SequenceNode {
+					continue
+				}
+				for _, subConfig := range configs.Content {
+					m.migrateConfig(ifaceCtx, subConfig, false)
+				}
+			}
 		}
-		v3.TemplateData["unroll-variadic"] = *v2Config.UnrollVariadic
 	}
-	if v2Config.WithExpecter != nil {
-		if v3.TemplateData == nil {
-			v3.TemplateData = map[string]any{}
+}
+
+// migrateConfig reports the deprecations found in a config map and rewrites
+// its parameters to their v3 form. The keys of the root config that aren't
+// parameters are left alone.
+func (m *nodeMigrator) migrateConfig(ctx context.Context, node *yaml.Node, isRoot bool) {
+	log := zerolog.Ctx(ctx)
+	node = resolveYAMLAlias(node)
+	if node.Kind != yaml.MappingNode || m.visited[node] {
+		return
+	}
+	m.visited[node] = true
+
+	var v2Config V2Config
+	if err := node.Decode(&v2Config); err != nil {
+		log.Warn().Err(err).Msg("failed to decode v2 config section")
+	} else {
+		reportV2Deprecations(ctx, m.tbl, &v2Config)
+	}
+
+	var templateData *yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == "template-data" {
+			templateData = resolveYAMLAlias(node.Content[i+1])
+		}
+	}
+
+	content := make([]*yaml.Node, 0, len(node.Content))
+	// The head comment of a removed key most likely describes the keys that
+	// follow it, so it's carried over to the next key that's kept.
+	pendingComment := ""
+	keep := func(key *yaml.Node, value *yaml.Node) {
+		if pendingComment != "" {
+			key.HeadComment = strings.TrimSpace(pendingComment + "\n" + key.HeadComment)
+			pendingComment = ""
 		}
-		v3.TemplateData["with-expecter"] = *v2Config.WithExpecter
+		content = append(content, key, value)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch {
+		case key.Value == "<<" && key.Tag == "!!merge":
+			merged := resolveYAMLAlias(value)
+			if merged.Kind == yaml.SequenceNode {
+				for _, item := range merged.Content {
+					m.migrateConfig(ctx, item, false)
+				}
+			} else {
+				m.migrateConfig(ctx, merged, false)
+			}
+			// yaml.v3 writes out the tag of merge keys explicitly unless
+			// it's left to be resolved implicitly.
+			key.Tag = ""
+			keep(key, value)
+		case isRoot && (key.Value == "packages" || key.Value == "_anchors"):
+			keep(key, value)
+		case v2RenamedParameters[key.Value] != "":
+			log.Debug().Str("parameter", key.Value).Str("new-parameter", v2RenamedParameters[key.Value]).Msg("renaming parameter")
+			key.Value = v2RenamedParameters[key.Value]
+			keep(key, value)
+		case v2TemplateDataParameters[key.Value]:
+			log.Debug().Str("parameter", key.Value).Msg("moving parameter to template-data")
+			if templateData == nil {
+				templateData = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+				keep(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "template-data"}, templateData)
+			}
+			if pendingComment != "" {
+				key.HeadComment = strings.TrimSpace(pendingComment + "\n" + key.HeadComment)
+				pendingComment = ""
+			}
+			templateData.Content = append(templateData.Content, key, value)
+		case v2RemovedParameters[key.Value]:
+			log.Debug().Str("parameter", key.Value).Msg("removing parameter")
+			if key.HeadComment != "" {
+				pendingComment = strings.TrimSpace(pendingComment + "\n" + key.HeadComment)
+			}
+		default:
+			keep(key, value)
+		}
+	}
+	if pendingComment != "" && len(content) != 0 {
+		last := content[len(content)-2]
+		last.FootComment = strings.TrimSpace(last.FootComment + "\n" + pendingComment)
 	}
+	node.Content = content
 }
 
 type V2RootConfig struct {
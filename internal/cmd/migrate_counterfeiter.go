@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/vektra/mockery/v3/config"
+)
+
+// counterfeiterBoolFlags are the counterfeiter flags that don't take a value.
+var counterfeiterBoolFlags = map[string]bool{
+	"generate": true,
+	"p":        true,
+	"q":        true,
+}
+
+// runCounterfeiterMigration converts the counterfeiter directives found under
+// dirs, both `//go:generate counterfeiter` and `//counterfeiter:generate`, into
+// a mockery config written to v3ConfPath. Paths in the config are relative to
+// rootDir, the directory mockery is expected to run from.
+func runCounterfeiterMigration(ctx context.Context, rootDir string, dirs []string, v3ConfPath string) error {
+	log := zerolog.Ctx(ctx)
+
+	goGenerateDirectives, err := scanDirectives(ctx, dirs, "//go:generate ")
+	if err != nil {
+		return err
+	}
+	counterfeiterDirectives, err := scanDirectives(ctx, dirs, "//counterfeiter:generate ")
+	if err != nil {
+		return err
+	}
+	tbl := newTableWriter(ctx)
+	modules := newModuleResolver()
+	builder := newMigratedConfigBuilder()
+
+	type invocation struct {
+		directive directive
+		args      []string
+	}
+	invocations := []invocation{}
+	for _, d := range goGenerateDirectives {
+		if args, ok := generatorArgs(d.Args, "counterfeiter"); ok {
+			invocations = append(invocations, invocation{directive: d, args: args})
+		}
+	}
+	for _, d := range counterfeiterDirectives {
+		invocations = append(invocations, invocation{directive: d, args: d.Args})
+	}
+
+	migrated := 0
+	for _, inv := range invocations {
+		directiveLog := log.With().Str("directive", inv.directive.Location()).Logger()
+		ok, err := migrateCounterfeiterDirective(directiveLog.WithContext(ctx), rootDir, inv.directive, inv.args, modules, builder, tbl)
+		if err != nil {
+			directiveLog.Debug().Err(err).Msg("directive could not be migrated")
+			tbl.Append("unmigratable-directive", fmt.Sprintf("%s: %v", inv.directive.Location(), err))
+			continue
+		}
+		if ok {
+			migrated++
+		}
+	}
+	log.Info().Int("directives", migrated).Msg("migrated counterfeiter directives")
+	if migrated == 0 {
+		return fmt.Errorf("no counterfeiter directives could be migrated")
+	}
+
+	v3 := builder.Build()
+	v3.Template = addr("counterfeiter")
+
+	if err := writeMigratedConfig(ctx, v3, v3ConfPath); err != nil {
+		return err
+	}
+	if len(tbl.seenMessages) != 0 {
+		log.Warn().Msg("counterfeiter options detected that could not be migrated. See table below.")
+		tbl.Render()
+	}
+	return nil
+}
+
+// migrateCounterfeiterDirective adds the interface faked by a single
+// counterfeiter invocation to builder. It returns false without an error for
+// invocations that only trigger the processing of `//counterfeiter:generate`
+// directives.
+func migrateCounterfeiterDirective(
+	ctx context.Context,
+	rootDir string,
+	d directive,
+	args []string,
+	modules *moduleResolver,
+	builder *migratedConfigBuilder,
+	tbl *tableWriter,
+) (bool, error) {
+	log := zerolog.Ctx(ctx)
+	flags, positional := parseGeneratorFlags(args, counterfeiterBoolFlags)
+
+	toStdout := false
+	if len(positional) != 0 && positional[len(positional)-1] == "-" {
+		toStdout = true
+		positional = positional[:len(positional)-1]
+	}
+	if flags["generate"] != "" && len(positional) == 0 {
+		return false, nil
+	}
+	if flags["p"] != "" {
+		return false, fmt.Errorf("package mode (`-p`) generates shims for functions, which mockery does not support")
+	}
+
+	var sourcePath, ifaceName string
+	switch len(positional) {
+	case 1:
+		// Either `import/path.Interface` or an interface in the current
+		// directory.
+		if idx := strings.LastIndex(positional[0], "."); idx > 0 {
+			sourcePath, ifaceName = positional[0][:idx], positional[0][idx+1:]
+		} else {
+			sourcePath, ifaceName = ".", positional[0]
+		}
+	case 2:
+		sourcePath, ifaceName = positional[0], positional[1]
+	default:
+		return false, fmt.Errorf("unrecognized counterfeiter invocation: %s", strings.Join(args, " "))
+	}
+
+	var (
+		pkgPath  string
+		ifaceDir string
+		err      error
+	)
+	if sourcePath == "." || strings.HasPrefix(sourcePath, "./") || strings.HasPrefix(sourcePath, "../") || filepath.IsAbs(sourcePath) {
+		ifaceDir = sourcePath
+		if !filepath.IsAbs(ifaceDir) {
+			ifaceDir = filepath.Join(d.Dir(), ifaceDir)
+		}
+		if pkgPath, err = modules.ImportPath(ifaceDir); err != nil {
+			return false, err
+		}
+	} else {
+		pkgPath = sourcePath
+		if ifaceDir, err = modules.PackageDir(d.Dir(), pkgPath); err != nil {
+			return false, err
+		}
+	}
+	var srcPkgName string
+	if ifaceDir != "" {
+		if srcPkgName, err = dirPackageName(ifaceDir); err != nil {
+			return false, err
+		}
+	}
+
+	conf := &config.Config{
+		// counterfeiter writes fakes to <package>fakes/fake_<interface>.go by
+		// default.
+		Dir:        addr("{{.InterfaceDir}}/{{.SrcPackageName}}fakes"),
+		FileName:   addr("fake_{{.InterfaceName | snakecase}}.go"),
+		PkgName:    addr("{{.SrcPackageName}}fakes"),
+		StructName: addr("Fake{{.InterfaceName}}"),
+	}
+	if output := flags["o"]; output != "" && !toStdout {
+		if !filepath.IsAbs(output) {
+			output = filepath.Join(d.Dir(), output)
+		}
+		mockDir := output
+		if strings.HasSuffix(output, ".go") {
+			mockDir = filepath.Dir(output)
+			conf.FileName = addr(inferFileName(filepath.Base(output), ifaceName))
+		}
+		conf.Dir = addr(inferDir(rootDir, ifaceDir, mockDir, pkgPath))
+		conf.PkgName = addr(inferPkgName(filepath.Base(mockDir), srcPkgName))
+	}
+	if toStdout {
+		tbl.Append("counterfeiter-option", fmt.Sprintf("%s: the fake is written to stdout. Counterfeiter's default location is used instead.", d.Location()))
+	}
+	if fakeName := flags["fake-name"]; fakeName != "" {
+		conf.StructName = addr(fakeName)
+	}
+	if header := flags["header"]; header != "" {
+		if !filepath.IsAbs(header) {
+			header = filepath.Join(d.Dir(), header)
+		}
+		if rel, err := filepath.Rel(rootDir, header); err == nil {
+			header = filepath.ToSlash(rel)
+		}
+		conf.TemplateData = map[string]any{"boilerplate-file": header}
+	}
+	if flags["q"] != "" {
+		tbl.Append("counterfeiter-option", "`-q` has no equivalent in mockery. Use `log-level` instead.")
+	}
+	for flag := range flags {
+		switch flag {
+		case "generate", "o", "p", "q", "fake-name", "header":
+		default:
+			tbl.Append("counterfeiter-option", fmt.Sprintf("`-%s` is not a known counterfeiter option and was not migrated.", flag))
+		}
+	}
+
+	log.Debug().Str("package", pkgPath).Str("interface", ifaceName).Msg("migrated interface")
+	builder.Add(pkgPath, ifaceName, conf)
+	return true, nil
+}
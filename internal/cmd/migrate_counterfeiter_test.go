@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chigopher/pathlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateCounterfeiter(t *testing.T) {
+	tmpdir := pathlib.NewPath(t.TempDir())
+	writeFile := func(path string, contents string) {
+		p := tmpdir.Join(strings.Split(path, "/")...)
+		require.NoError(t, p.Parent().MkdirAll())
+		require.NoError(t, p.WriteFile([]byte(contents)))
+	}
+	writeFile("go.mod", "module github.com/org/repo\n\ngo 1.23\n")
+	writeFile("store/store.go", `package store
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+//counterfeiter:generate . Store
+type Store interface {
+	Get(key string) (string, error)
+}
+
+//counterfeiter:generate . KeyValueCache
+type KeyValueCache interface {
+	Flush()
+}
+`)
+	writeFile("api/api.go", `package api
+
+//go:generate counterfeiter -o fakes/client.go --fake-name StubClient . Client
+//go:generate counterfeiter -p os
+
+type Client interface {
+	Do() error
+}
+`)
+	writeFile("std/std.go", `package std
+
+//go:generate counterfeiter -o ../internal/fakes io.Writer
+`)
+	v3File := tmpdir.Join("v3_config.yml")
+
+	require.NoError(t, runCounterfeiterMigration(context.Background(), tmpdir.String(), []string{tmpdir.String()}, v3File.String()))
+
+	b, err := v3File.ReadFile()
+	require.NoError(t, err)
+	assert.Equal(t, `template: counterfeiter
+packages:
+  github.com/org/repo/api:
+    config:
+      dir: '{{.InterfaceDir}}/fakes'
+      filename: '{{.InterfaceName | snakecase}}.go'
+      structname: StubClient
+      pkgname: fakes
+    interfaces:
+      Client: {}
+  github.com/org/repo/store:
+    config:
+      dir: '{{.InterfaceDir}}/{{.SrcPackageName}}fakes'
+      filename: fake_{{.InterfaceName | snakecase}}.go
+      structname: Fake{{.InterfaceName}}
+      pkgname: '{{.SrcPackageName}}fakes'
+    interfaces:
+      KeyValueCache: {}
+      Store: {}
+  io:
+    config:
+      dir: internal/fakes
+      filename: fake_{{.InterfaceName | snakecase}}.go
+      structname: Fake{{.InterfaceName}}
+      pkgname: fakes
+    interfaces:
+      Writer: {}
+`, string(b))
+}
@@ -0,0 +1,448 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/chigopher/pathlib"
+	"github.com/rs/zerolog"
+	"github.com/vektra/mockery/v3/config"
+	"golang.org/x/mod/modfile"
+	"gopkg.in/yaml.v3"
+)
+
+// directive is a code generation comment found in a Go file, split into its
+// arguments the same way `go generate` does.
+type directive struct {
+	// File is the absolute path of the file containing the directive.
+	File string
+	Line int
+	Args []string
+}
+
+func (d directive) Location() string {
+	return fmt.Sprintf("%s:%d", d.File, d.Line)
+}
+
+// Dir returns the directory containing the directive, which is the working
+// directory of the generator it invokes.
+func (d directive) Dir() string {
+	return filepath.Dir(d.File)
+}
+
+// scanDirectives walks each of the given directories and returns every comment
+// line that starts with prefix, such as "//go:generate ". Hidden directories,
+// vendor and testdata are skipped.
+func scanDirectives(ctx context.Context, dirs []string, prefix string) ([]directive, error) {
+	log := zerolog.Ctx(ctx)
+	directives := []directive{}
+	for _, root := range dirs {
+		root, err := filepath.Abs(root)
+		if err != nil {
+			return nil, err
+		}
+		err = filepath.WalkDir(root, func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				name := d.Name()
+				if filePath != root && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(filePath, ".go") {
+				return nil
+			}
+			fileDirectives, err := scanFileDirectives(filePath, prefix)
+			if err != nil {
+				return err
+			}
+			if len(fileDirectives) != 0 {
+				log.Debug().Str("file", filePath).Int("directives", len(fileDirectives)).Msg("found directives")
+			}
+			directives = append(directives, fileDirectives...)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("scanning %s: %w", root, err)
+		}
+	}
+	return directives, nil
+}
+
+func scanFileDirectives(filePath string, prefix string) ([]directive, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	directives := []directive{}
+	var pkgName string
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line, ok := strings.CutPrefix(scanner.Text(), prefix)
+		if !ok {
+			continue
+		}
+		if pkgName == "" {
+			pkgName, err = goPackageName(filePath)
+			if err != nil {
+				return nil, err
+			}
+		}
+		args, err := splitDirectiveArgs(line, func(name string) string {
+			switch name {
+			case "GOFILE":
+				return filepath.Base(filePath)
+			case "GOPACKAGE":
+				return pkgName
+			case "GOLINE":
+				return strconv.Itoa(lineNum)
+			case "DOLLAR":
+				return "$"
+			}
+			return os.Getenv(name)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filePath, lineNum, err)
+		}
+		directives = append(directives, directive{File: filePath, Line: lineNum, Args: args})
+	}
+	return directives, scanner.Err()
+}
+
+// splitDirectiveArgs splits line into space-separated arguments. Double-quoted
+// arguments are unquoted as Go strings, and environment variables are expanded
+// using mapping, mirroring `go generate`.
+func splitDirectiveArgs(line string, mapping func(string) string) ([]string, error) {
+	args := []string{}
+	line = strings.TrimSpace(line)
+	for line != "" {
+		var arg string
+		if line[0] == '"' {
+			end := 1
+			for ; end < len(line); end++ {
+				if line[end] == '\\' {
+					end++
+					continue
+				}
+				if line[end] == '"' {
+					break
+				}
+			}
+			if end >= len(line) {
+				return nil, errors.New("unterminated quoted string")
+			}
+			unquoted, err := strconv.Unquote(line[:end+1])
+			if err != nil {
+				return nil, err
+			}
+			arg, line = unquoted, line[end+1:]
+		} else {
+			end := strings.IndexFunc(line, unicode.IsSpace)
+			if end < 0 {
+				end = len(line)
+			}
+			arg, line = line[:end], line[end:]
+		}
+		args = append(args, os.Expand(arg, mapping))
+		line = strings.TrimLeftFunc(line, unicode.IsSpace)
+	}
+	return args, nil
+}
+
+// generatorArgs reports whether args invoke the named generator, either
+// directly or through `go run`, and returns the arguments passed to it.
+func generatorArgs(args []string, name string) ([]string, bool) {
+	isGenerator := func(cmd string) bool {
+		cmd, _, _ = strings.Cut(cmd, "@")
+		// Strip the major version suffix of module paths such as
+		// github.com/maxbrunsfeld/counterfeiter/v6.
+		if dir, base := path.Split(cmd); dir != "" && len(base) > 1 && base[0] == 'v' {
+			if _, err := strconv.Atoi(base[1:]); err == nil {
+				cmd = path.Clean(dir)
+			}
+		}
+		return cmd == name || path.Base(cmd) == name
+	}
+	if len(args) == 0 {
+		return nil, false
+	}
+	if isGenerator(args[0]) {
+		return args[1:], true
+	}
+	if len(args) < 3 || args[0] != "go" || args[1] != "run" {
+		return nil, false
+	}
+	for i := 2; i < len(args); i++ {
+		if strings.HasPrefix(args[i], "-") {
+			continue
+		}
+		if isGenerator(args[i]) {
+			return args[i+1:], true
+		}
+		return nil, false
+	}
+	return nil, false
+}
+
+// parseGeneratorFlags splits args into Go-style flags and the remaining
+// positional arguments. boolFlags lists the flags that don't take a value
+// unless it's given with `=`.
+func parseGeneratorFlags(args []string, boolFlags map[string]bool) (map[string]string, []string) {
+	flags := map[string]string{}
+	for len(args) != 0 {
+		arg := args[0]
+		if arg == "--" {
+			return flags, args[1:]
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			break
+		}
+		args = args[1:]
+		name := strings.TrimLeft(arg, "-")
+		if name, value, hasValue := strings.Cut(name, "="); hasValue {
+			flags[name] = value
+			continue
+		}
+		if boolFlags[name] || len(args) == 0 {
+			flags[name] = "true"
+			continue
+		}
+		flags[name], args = args[0], args[1:]
+	}
+	return flags, args
+}
+
+// goPackageName returns the package name declared in the Go file at filePath.
+func goPackageName(filePath string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filePath, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	return file.Name.Name, nil
+}
+
+// moduleResolver maps directories to import paths and back, using the go.mod
+// files found in their parent directories.
+type moduleResolver struct {
+	// modules maps the directory of each go.mod seen so far to its module
+	// path.
+	modules map[string]string
+}
+
+func newModuleResolver() *moduleResolver {
+	return &moduleResolver{modules: map[string]string{}}
+}
+
+func (m *moduleResolver) module(dir string) (string, string, error) {
+	for modDir := dir; ; {
+		if modPath, ok := m.modules[modDir]; ok {
+			return modDir, modPath, nil
+		}
+		b, err := os.ReadFile(filepath.Join(modDir, "go.mod"))
+		if err == nil {
+			modPath := modfile.ModulePath(b)
+			if modPath == "" {
+				return "", "", fmt.Errorf("%s has no module directive", filepath.Join(modDir, "go.mod"))
+			}
+			m.modules[modDir] = modPath
+			return modDir, modPath, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", "", err
+		}
+		parent := filepath.Dir(modDir)
+		if parent == modDir {
+			return "", "", fmt.Errorf("no go.mod found for %s", dir)
+		}
+		modDir = parent
+	}
+}
+
+// ImportPath returns the import path of the package in dir.
+func (m *moduleResolver) ImportPath(dir string) (string, error) {
+	modDir, modPath, err := m.module(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(modDir, dir)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(modPath, filepath.ToSlash(rel)), nil
+}
+
+// PackageDir returns the directory of the package with the given import path,
+// provided it belongs to the same module as fromDir. An empty string is
+// returned for packages of other modules.
+func (m *moduleResolver) PackageDir(fromDir string, importPath string) (string, error) {
+	modDir, modPath, err := m.module(fromDir)
+	if err != nil {
+		return "", err
+	}
+	if importPath == modPath {
+		return modDir, nil
+	}
+	rel, ok := strings.CutPrefix(importPath, modPath+"/")
+	if !ok {
+		return "", nil
+	}
+	return filepath.Join(modDir, filepath.FromSlash(rel)), nil
+}
+
+// migratedConfigBuilder collects the config of each interface found while
+// migrating from another generator and assembles them into a RootConfig.
+type migratedConfigBuilder struct {
+	packages map[string]map[string][]*config.Config
+}
+
+func newMigratedConfigBuilder() *migratedConfigBuilder {
+	return &migratedConfigBuilder{packages: map[string]map[string][]*config.Config{}}
+}
+
+// Add records that a mock of the given interface should be generated with
+// conf. Adding the same interface more than once results in a `configs`
+// entry for each distinct config.
+func (b *migratedConfigBuilder) Add(pkgPath string, interfaceName string, conf *config.Config) {
+	if b.packages[pkgPath] == nil {
+		b.packages[pkgPath] = map[string][]*config.Config{}
+	}
+	for _, existing := range b.packages[pkgPath][interfaceName] {
+		if reflect.DeepEqual(existing, conf) {
+			return
+		}
+	}
+	b.packages[pkgPath][interfaceName] = append(b.packages[pkgPath][interfaceName], conf)
+}
+
+// Build returns the RootConfig containing every interface added. Parameters
+// shared by every interface of a package are moved to the package's config,
+// and parameters shared by every package are moved to the root.
+func (b *migratedConfigBuilder) Build() config.RootConfig {
+	root := config.RootConfig{Packages: map[string]*config.PackageConfig{}}
+	pkgConfigs := []*config.Config{}
+	for pkgPath, interfaces := range b.packages {
+		pkgConfig := &config.PackageConfig{
+			Config:     &config.Config{},
+			Interfaces: map[string]*config.InterfaceConfig{},
+		}
+		ifaceConfigs := []*config.Config{}
+		hasMultipleConfigs := false
+		for ifaceName, confs := range interfaces {
+			ifaceConfig := &config.InterfaceConfig{}
+			if len(confs) == 1 {
+				ifaceConfig.Config = confs[0]
+				ifaceConfigs = append(ifaceConfigs, confs[0])
+			} else {
+				ifaceConfig.Configs = confs
+				hasMultipleConfigs = true
+			}
+			pkgConfig.Interfaces[ifaceName] = ifaceConfig
+		}
+		// Each entry of `configs` inherits the package config, so hoisting
+		// could leak parameters into entries that didn't set them.
+		if !hasMultipleConfigs {
+			hoistSharedParameters(pkgConfig.Config, ifaceConfigs)
+		}
+		for _, ifaceConfig := range pkgConfig.Interfaces {
+			if ifaceConfig.Config != nil && reflect.ValueOf(*ifaceConfig.Config).IsZero() {
+				ifaceConfig.Config = nil
+			}
+		}
+		pkgConfigs = append(pkgConfigs, pkgConfig.Config)
+		root.Packages[pkgPath] = pkgConfig
+	}
+	hoistSharedParameters(&root.Config, pkgConfigs)
+	for _, pkgConfig := range root.Packages {
+		if reflect.ValueOf(*pkgConfig.Config).IsZero() {
+			pkgConfig.Config = nil
+		}
+	}
+	return root
+}
+
+// hoistSharedParameters moves each parameter that is set to the same value in
+// every one of children into parent.
+func hoistSharedParameters(parent *config.Config, children []*config.Config) {
+	if len(children) == 0 {
+		return
+	}
+	parentValue := reflect.ValueOf(parent).Elem()
+	for i := range parentValue.NumField() {
+		first := reflect.ValueOf(children[0]).Elem().Field(i)
+		if first.IsZero() {
+			continue
+		}
+		shared := true
+		for _, child := range children[1:] {
+			if !reflect.DeepEqual(first.Interface(), reflect.ValueOf(child).Elem().Field(i).Interface()) {
+				shared = false
+				break
+			}
+		}
+		if !shared {
+			continue
+		}
+		parentValue.Field(i).Set(first)
+		for _, child := range children {
+			field := reflect.ValueOf(child).Elem().Field(i)
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+}
+
+func writeMigratedConfig(ctx context.Context, v3 config.RootConfig, v3ConfPath string) error {
+	log := zerolog.Ctx(ctx)
+
+	outFile := pathlib.NewPath(v3ConfPath)
+	file, err := outFile.OpenFile(os.O_CREATE | os.O_RDWR | os.O_TRUNC)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", outFile, err)
+	}
+	defer file.Close()
+
+	encoder := yaml.NewEncoder(file)
+	defer encoder.Close()
+	encoder.SetIndent(2)
+
+	log.Info().Str("v3-config", outFile.String()).Msg("writing v3 config")
+	if err := encoder.Encode(v3); err != nil {
+		return fmt.Errorf("encoding %s: %w", outFile, err)
+	}
+	return nil
+}
+
+// runGeneratorMigration runs a migration from another generator over dirs,
+// defaulting to the working directory, with paths made relative to the
+// working directory.
+func runGeneratorMigration(
+	ctx context.Context,
+	dirs []string,
+	v3ConfPath string,
+	migrate func(ctx context.Context, rootDir string, dirs []string, v3ConfPath string) error,
+) error {
+	rootDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	return migrate(ctx, rootDir, dirs, v3ConfPath)
+}
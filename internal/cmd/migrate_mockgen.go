@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/vektra/mockery/v3/config"
+)
+
+// mockgenBoolFlags are the mockgen flags that don't take a value.
+var mockgenBoolFlags = map[string]bool{
+	"debug_parser":             true,
+	"prog_only":                true,
+	"typed":                    true,
+	"version":                  true,
+	"write_generate_directive": true,
+	"write_package_comment":    true,
+	"write_source_comment":     true,
+}
+
+// mockgenUnsupportedFlags maps the mockgen flags that have no equivalent in
+// mockery to the message reported when they're encountered.
+var mockgenUnsupportedFlags = map[string]string{
+	"aux_files":                "`-aux_files` is not needed. Mockery loads the entire source package.",
+	"build_flags":              "`-build_flags` cannot be set per mock. Use the top-level `build-tags` parameter if the flags select build tags.",
+	"debug_parser":             "`-debug_parser` has no equivalent in mockery.",
+	"exec_only":                "`-exec_only` has no equivalent in mockery.",
+	"imports":                  "`-imports` is not needed. Mockery resolves imports from the loaded source package.",
+	"model_gob":                "`-model_gob` has no equivalent in mockery.",
+	"prog_only":                "`-prog_only` has no equivalent in mockery.",
+	"self_package":             "`-self_package` is not needed. Mockery detects the package of the mock automatically.",
+	"typed":                    "`-typed` is not needed. The gomock template always generates typed calls.",
+	"write_generate_directive": "`-write_generate_directive` has no equivalent in mockery.",
+	"write_package_comment":    "`-write_package_comment` has no equivalent in mockery.",
+	"write_source_comment":     "`-write_source_comment` has no equivalent in mockery.",
+}
+
+// runMockgenMigration converts the `//go:generate mockgen` directives found
+// under dirs into a mockery config written to v3ConfPath. Paths in the config
+// are relative to rootDir, the directory mockery is expected to run from.
+func runMockgenMigration(ctx context.Context, rootDir string, dirs []string, v3ConfPath string) error {
+	log := zerolog.Ctx(ctx)
+
+	directives, err := scanDirectives(ctx, dirs, "//go:generate ")
+	if err != nil {
+		return err
+	}
+	tbl := newTableWriter(ctx)
+	modules := newModuleResolver()
+	builder := newMigratedConfigBuilder()
+
+	migrated := 0
+	for _, d := range directives {
+		args, ok := generatorArgs(d.Args, "mockgen")
+		if !ok {
+			continue
+		}
+		directiveLog := log.With().Str("directive", d.Location()).Logger()
+		if err := migrateMockgenDirective(directiveLog.WithContext(ctx), rootDir, d, args, modules, builder, tbl); err != nil {
+			directiveLog.Debug().Err(err).Msg("directive could not be migrated")
+			tbl.Append("unmigratable-directive", fmt.Sprintf("%s: %v", d.Location(), err))
+			continue
+		}
+		migrated++
+	}
+	log.Info().Int("directives", migrated).Msg("migrated mockgen directives")
+	if migrated == 0 {
+		return fmt.Errorf("no mockgen directives could be migrated")
+	}
+
+	v3 := builder.Build()
+	v3.Template = addr("gomock")
+
+	if err := writeMigratedConfig(ctx, v3, v3ConfPath); err != nil {
+		return err
+	}
+	if len(tbl.seenMessages) != 0 {
+		log.Warn().Msg("mockgen options detected that could not be migrated. See table below.")
+		tbl.Render()
+	}
+	return nil
+}
+
+func migrateMockgenDirective(
+	ctx context.Context,
+	rootDir string,
+	d directive,
+	args []string,
+	modules *moduleResolver,
+	builder *migratedConfigBuilder,
+	tbl *tableWriter,
+) error {
+	log := zerolog.Ctx(ctx)
+	flags, positional := parseGeneratorFlags(args, mockgenBoolFlags)
+
+	var (
+		pkgPath    string
+		ifaceDir   string
+		srcPkgName string
+		ifaceNames []string
+		err        error
+	)
+	switch {
+	case flags["archive"] != "":
+		return fmt.Errorf("archive mode is not supported")
+	case flags["source"] != "":
+		source := flags["source"]
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(d.Dir(), source)
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), source, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parsing source file: %w", err)
+		}
+		ifaceNames = declaredInterfaces(file)
+		srcPkgName = file.Name.Name
+		ifaceDir = filepath.Dir(source)
+		if pkgPath, err = modules.ImportPath(ifaceDir); err != nil {
+			return err
+		}
+	case len(positional) == 2:
+		pkgPath = positional[0]
+		if pkgPath == "." {
+			if pkgPath, err = modules.ImportPath(d.Dir()); err != nil {
+				return err
+			}
+		}
+		ifaceNames = strings.Split(positional[1], ",")
+		if ifaceDir, err = modules.PackageDir(d.Dir(), pkgPath); err != nil {
+			return err
+		}
+		if ifaceDir != "" {
+			if srcPkgName, err = dirPackageName(ifaceDir); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unrecognized mockgen invocation: %s", strings.Join(args, " "))
+	}
+
+	excluded := map[string]bool{}
+	for _, name := range strings.Split(flags["exclude_interfaces"], ",") {
+		excluded[name] = true
+	}
+	mockNames := map[string]string{}
+	for _, mapping := range strings.Split(flags["mock_names"], ",") {
+		if ifaceName, mockName, ok := strings.Cut(mapping, "="); ok {
+			mockNames[ifaceName] = mockName
+		}
+	}
+
+	base := config.Config{}
+	if pkgName := flags["package"]; pkgName != "" {
+		base.PkgName = addr(inferPkgName(pkgName, srcPkgName))
+	} else {
+		base.PkgName = addr("mock_{{.SrcPackageName}}")
+	}
+	if copyrightFile := flags["copyright_file"]; copyrightFile != "" {
+		if !filepath.IsAbs(copyrightFile) {
+			copyrightFile = filepath.Join(d.Dir(), copyrightFile)
+		}
+		if rel, err := filepath.Rel(rootDir, copyrightFile); err == nil {
+			copyrightFile = filepath.ToSlash(rel)
+		}
+		base.TemplateData = map[string]any{"boilerplate-file": copyrightFile}
+	}
+	if buildConstraint := flags["build_constraint"]; buildConstraint != "" {
+		if base.TemplateData == nil {
+			base.TemplateData = map[string]any{}
+		}
+		base.TemplateData["mock-build-tags"] = buildConstraint
+	}
+	for flag := range flags {
+		if msg, unsupported := mockgenUnsupportedFlags[flag]; unsupported {
+			tbl.Append("mockgen-option", msg)
+		}
+	}
+
+	var mockDir, fileName string
+	if destination := flags["destination"]; destination != "" {
+		if !filepath.IsAbs(destination) {
+			destination = filepath.Join(d.Dir(), destination)
+		}
+		mockDir, fileName = filepath.Dir(destination), filepath.Base(destination)
+	} else {
+		tbl.Append("mockgen-option", fmt.Sprintf("%s: mocks are written to stdout without `-destination`. Mockery's default `dir` and `filename` are used instead.", d.Location()))
+	}
+
+	count := 0
+	for _, ifaceName := range ifaceNames {
+		if ifaceName == "" || excluded[ifaceName] {
+			continue
+		}
+		conf := base
+		if mockDir != "" {
+			conf.Dir = addr(inferDir(rootDir, ifaceDir, mockDir, pkgPath))
+			// Several interfaces written to the same file can't share a
+			// templated filename.
+			if len(ifaceNames) == 1 {
+				conf.FileName = addr(inferFileName(fileName, ifaceName))
+			} else {
+				conf.FileName = addr(fileName)
+			}
+		}
+		if mockName, ok := mockNames[ifaceName]; ok {
+			conf.StructName = addr(mockName)
+		} else {
+			conf.StructName = addr("Mock{{.InterfaceName}}")
+		}
+		log.Debug().Str("package", pkgPath).Str("interface", ifaceName).Msg("migrated interface")
+		builder.Add(pkgPath, ifaceName, &conf)
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("no interfaces to mock")
+	}
+	return nil
+}
+
+// declaredInterfaces returns the names of the interfaces declared at the top
+// level of file.
+func declaredInterfaces(file *ast.File) []string {
+	interfaces := []string{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, isInterface := typeSpec.Type.(*ast.InterfaceType); isInterface {
+				interfaces = append(interfaces, typeSpec.Name.Name)
+			}
+		}
+	}
+	return interfaces
+}
+
+// dirPackageName returns the name of the package in dir, as declared by its
+// first non-test Go file.
+func dirPackageName(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		return goPackageName(filepath.Join(dir, name))
+	}
+	return "", nil
+}
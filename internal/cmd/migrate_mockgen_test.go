@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chigopher/pathlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedMockgenV3Conf = `template: gomock
+packages:
+  github.com/org/repo/api:
+    config:
+      dir: '{{.InterfaceDir}}'
+      filename: mock_{{.InterfaceName | snakecase}}_test.go
+      structname: Mock{{.InterfaceName}}
+      pkgname: '{{.SrcPackageName}}'
+    interfaces:
+      Client: {}
+      Server: {}
+  github.com/org/repo/store:
+    config:
+      dir: '{{.InterfaceDir}}/mocks'
+      filename: mock_store.go
+      pkgname: mocks
+      template-data:
+        boilerplate-file: hack/boilerplate.txt
+    interfaces:
+      Cache:
+        config:
+          structname: Mock{{.InterfaceName}}
+      Store:
+        config:
+          structname: FakeStore
+`
+
+func TestMigrateMockgen(t *testing.T) {
+	tmpdir := pathlib.NewPath(t.TempDir())
+	writeFile := func(path string, contents string) {
+		p := tmpdir.Join(strings.Split(path, "/")...)
+		require.NoError(t, p.Parent().MkdirAll())
+		require.NoError(t, p.WriteFile([]byte(contents)))
+	}
+	writeFile("go.mod", "module github.com/org/repo\n\ngo 1.23\n")
+	writeFile("store/store.go", `package store
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_store.go -package=mocks -mock_names=Store=FakeStore -copyright_file=../hack/boilerplate.txt -self_package=github.com/org/repo/store/mocks
+
+type Store interface {
+	Get(key string) (string, error)
+}
+
+type Cache interface {
+	Store
+	Flush()
+}
+
+type notAnInterface struct{}
+`)
+	writeFile("api/api.go", `package api
+
+//go:generate go run go.uber.org/mock/mockgen@v0.5.0 -typed -destination=mock_client_test.go -package=api . Client
+//go:generate mockgen -destination mock_server_test.go -package api github.com/org/repo/api Server
+//go:generate stringer -type=Kind
+
+type Client interface {
+	Do() error
+}
+
+type Server interface {
+	Serve() error
+}
+`)
+	writeFile("broken/broken.go", `package broken
+
+//go:generate mockgen -archive=pkg.a -destination=mock.go -package=broken github.com/org/repo/broken Iface
+`)
+	v3File := tmpdir.Join("v3_config.yml")
+
+	require.NoError(t, runMockgenMigration(context.Background(), tmpdir.String(), []string{tmpdir.String()}, v3File.String()))
+
+	b, err := v3File.ReadFile()
+	require.NoError(t, err)
+	assert.Equal(t, expectedMockgenV3Conf, string(b))
+}
+
+func Test_splitDirectiveArgs(t *testing.T) {
+	args, err := splitDirectiveArgs(`mockgen -source=$GOFILE "-mock_names=A=B C" -package $DOLLAR`, func(name string) string {
+		return map[string]string{"GOFILE": "foo.go", "DOLLAR": "$"}[name]
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mockgen", "-source=foo.go", "-mock_names=A=B C", "-package", "$"}, args)
+
+	_, err = splitDirectiveArgs(`mockgen "-package`, nil)
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/chigopher/pathlib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektra/mockery/v3/config"
 )
 
 var v2ConfigExample string = `
@@ -150,132 +151,112 @@ packages:
       AutoGenerated: # include-auto-generated is false, so should be ignored
 `
 
-var expectedV3Conf string = `_anchors:
-  all: true
-  dir: '{{.InterfaceDir}}'
-  filename: mock_{{.InterfaceNameSnake}}_test.go
-  inpackage: false
-  mockname: Mock{{.InterfaceName}}
-  outpkg: '{{.PackageName}}_test'
-structname: '{{.InterfaceName}}'
+var expectedV3Conf string = `template-data:
+  with-expecter: True
+structname: "{{.InterfaceName}}"
+filename: "{{.StructName}}_mock.go"
 pkgname: mocks
+_anchors: &inpackage_config
+  all: True
+  dir: "{{.InterfaceDir}}"
+  structname: "Mock{{.InterfaceName}}"
+  pkgname: "{{.PackageName}}_test"
+  filename: "mock_{{.InterfaceNameSnake}}_test.go"
 template: testify
-template-data:
-  with-expecter: true
 packages:
-  github.com/vektra/mockery/v2/pkg:
+  github.com/vektra/mockery/v2/pkg/fixtures/buildtag/comment:
+    config:
+      template-data:
+        mock-build-tags: "custom3 && (!windows || !darwin || !freebsd)"
     interfaces:
-      TypesPackage: {}
-  github.com/vektra/mockery/v2/pkg/:
+      IfaceWithCustomBuildTagInComment:
+  github.com/vektra/mockery/v2/pkg:
     interfaces:
-      InterfaceDoesntExist: {}
+      TypesPackage:
   github.com/vektra/mockery/v2/pkg/fixtures:
     config:
-      all: true
+      all: True
     interfaces:
-      Expecter:
+      RequesterArgSameAsNamedImport:
+      RequesterVariadic:
         config:
           template-data:
-            with-expecter: true
+            with-expecter: False
         configs:
-          - structname: ExpecterAndRolledVariadic
+          - structname: RequesterVariadicOneArgument
             template-data:
-              unroll-variadic: false
-          - structname: Expecter
+              unroll-variadic: False
+          - structname: RequesterVariadic
             template-data:
-              unroll-variadic: true
-      ReplaceGeneric:
-        config: {}
-      ReplaceGenericSelf:
-        config: {}
-      RequesterArgSameAsNamedImport: {}
-      RequesterReturnElided: {}
-      RequesterVariadic:
+              unroll-variadic: True
+      Expecter:
         config:
           template-data:
-            with-expecter: false
+            with-expecter: True
         configs:
-          - structname: RequesterVariadicOneArgument
+          - structname: ExpecterAndRolledVariadic
             template-data:
-              unroll-variadic: false
-          - structname: RequesterVariadic
+              unroll-variadic: False
+          - structname: Expecter
             template-data:
-              unroll-variadic: true
+              unroll-variadic: True
+      RequesterReturnElided:
       VariadicNoReturnInterface:
         config:
           template-data:
-            unroll-variadic: false
-            with-expecter: true
-  github.com/vektra/mockery/v2/pkg/fixtures/auto_generated:
-    config:
-      all: true
-    interfaces:
-      AutoGenerated: {}
-  github.com/vektra/mockery/v2/pkg/fixtures/buildtag/comment:
+            with-expecter: True
+            unroll-variadic: False
+      # Replace generic params with a new constraint and a new fixed value
+      ReplaceGeneric:
+        config: {}
+      # Replace a generic param with the parent type
+      ReplaceGenericSelf:
+        config: {}
+  github.com/vektra/mockery/v2/pkg/fixtures/recursive_generation:
     config:
-      template-data:
-        mock-build-tags: custom3 && (!windows || !darwin || !freebsd)
-    interfaces:
-      IfaceWithCustomBuildTagInComment: {}
+      recursive: True
+      all: True
+      dir: "{{.InterfaceDir}}"
+      filename: "{{.InterfaceName}}_mock.go"
+      structname: "Mock{{.InterfaceName}}"
+      pkgname: "{{.PackageName}}"
   github.com/vektra/mockery/v2/pkg/fixtures/empty_return:
     config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: '{{.InterfaceName}}Mock'
-      pkgname: '{{.PackageName}}'
-  github.com/vektra/mockery/v2/pkg/fixtures/example_project:
-    config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: Mock{{.InterfaceName}}
-      pkgname: '{{.PackageName}}_test'
-  github.com/vektra/mockery/v2/pkg/fixtures/iface_new_type:
+      all: True
+      dir: "{{.InterfaceDir}}"
+      structname: "{{.InterfaceName}}Mock"
+      pkgname: "{{.PackageName}}"
+      filename: "mock_{{.InterfaceName}}_test.go"
+  github.com/vektra/mockery/v2/pkg/fixtures/method_args/same_name_arg_and_type:
     config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: Mock{{.InterfaceName}}
-      pkgname: '{{.PackageName}}_test'
+      all: True
+      dir: "{{.InterfaceDir}}"
+      structname: "{{.InterfaceName}}Mock"
+      pkgname: "{{.PackageName}}"
+      filename: "mock_{{.InterfaceName}}_test.go"
   github.com/vektra/mockery/v2/pkg/fixtures/iface_typed_param:
-    config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: Mock{{.InterfaceName}}
-      pkgname: '{{.PackageName}}_test'
+    config: *inpackage_config
+  github.com/vektra/mockery/v2/pkg/fixtures/example_project:
+    config: *inpackage_config
   github.com/vektra/mockery/v2/pkg/fixtures/index_list_expr:
-    config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: Mock{{.InterfaceName}}
-      pkgname: '{{.PackageName}}_test'
+    config: *inpackage_config
+  github.com/vektra/mockery/v2/pkg/fixtures/iface_new_type:
+    config: *inpackage_config
   github.com/vektra/mockery/v2/pkg/fixtures/issue845:
     config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: Mock{{.InterfaceName}}
-      pkgname: '{{.PackageName}}_test'
+      <<: *inpackage_config
+      filename: "mock_{{.StructName}}_test.go"
     interfaces:
       Interface:
         configs:
           - structname: WithoutFix
           - structname: WithFix
-  github.com/vektra/mockery/v2/pkg/fixtures/method_args/same_name_arg_and_type:
-    config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: '{{.InterfaceName}}Mock'
-      pkgname: '{{.PackageName}}'
-  github.com/vektra/mockery/v2/pkg/fixtures/recursive_generation:
-    config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      structname: Mock{{.InterfaceName}}
-      pkgname: '{{.PackageName}}'
-      recursive: true
   github.com/vektra/mockery/v2/pkg/fixtures/type_alias:
     config:
-      all: true
-      dir: '{{.InterfaceDir}}'
-      pkgname: '{{.PackageName}}_test'
+      all: True
+      dir: "{{.InterfaceDir}}"
+      filename: "mock_{{.StructName}}_test.go"
+      pkgname: "{{.PackageName}}_test"
     interfaces:
       Interface1:
         configs:
@@ -285,6 +266,14 @@ packages:
         configs:
           - structname: Interface2WithUnresolvedAlias
           - structname: Interface2WithResolvedAlias
+  github.com/vektra/mockery/v2/pkg/:
+    interfaces:
+      InterfaceDoesntExist:
+  github.com/vektra/mockery/v2/pkg/fixtures/auto_generated:
+    config:
+      all: True
+    interfaces:
+      AutoGenerated: # include-auto-generated is false, so should be ignored
 `
 
 func TestMigrate(t *testing.T) {
@@ -293,9 +282,66 @@ func TestMigrate(t *testing.T) {
 	v3File := pathlib.NewPath(tmpdir).Join("v3_config.yml")
 
 	require.NoError(t, v2File.WriteFile([]byte(v2ConfigExample)))
-	require.NoError(t, run(context.Background(), v2File.String(), v3File.String()))
+	require.NoError(t, run(context.Background(), v2File.String(), v3File.String(), false))
 
 	b, err := v3File.ReadFile()
 	require.NoError(t, err)
 	assert.Equal(t, expectedV3Conf, string(b))
 }
+
+func TestMigrateInPlace(t *testing.T) {
+	v2Conf := `# Shared settings for all packages.
+_anchors:
+  base: &base
+    # Put mocks next to the interface.
+    dir: "{{.InterfaceDir}}"
+    outpkg: "{{.PackageName}}" # same package
+    # Mocks are only used in tests.
+    inpackage: True
+    with-expecter: True
+quiet: False
+# Names of mocks.
+mockname: "Mock{{.InterfaceName}}"
+packages:
+  github.com/org/repo:
+    config:
+      <<: *base
+      keeptree: False
+`
+	expected := `# Shared settings for all packages.
+_anchors:
+  base: &base
+    # Put mocks next to the interface.
+    dir: "{{.InterfaceDir}}"
+    pkgname: "{{.PackageName}}" # same package
+    # Mocks are only used in tests.
+    template-data:
+      with-expecter: True
+# Names of mocks.
+structname: "Mock{{.InterfaceName}}"
+template: testify
+packages:
+  github.com/org/repo:
+    config:
+      <<: *base
+`
+	tmpdir := t.TempDir()
+	confFile := pathlib.NewPath(tmpdir).Join(".mockery.yml")
+	require.NoError(t, confFile.WriteFile([]byte(v2Conf)))
+	require.NoError(t, run(context.Background(), confFile.String(), "", true))
+
+	b, err := confFile.ReadFile()
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(b))
+
+	backup, err := pathlib.NewPath(confFile.String() + ".bak").ReadFile()
+	require.NoError(t, err)
+	assert.Equal(t, v2Conf, string(backup))
+
+	validationErrors, err := config.ValidateFile(confFile.String())
+	require.NoError(t, err)
+	assert.Empty(t, validationErrors)
+
+	// An existing backup is never overwritten.
+	require.Error(t, run(context.Background(), confFile.String(), "", true))
+}
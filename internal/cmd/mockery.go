@@ -56,6 +56,8 @@ func NewRootCmd() (*cobra.Command, error) {
 	cmd.AddCommand(NewVersionCmd())
 	cmd.AddCommand(NewInitCmd())
 	cmd.AddCommand(NewMigrateCmd())
+	cmd.AddCommand(NewConfigCmd())
+	cmd.AddCommand(NewListCmd())
 	return cmd, nil
 }
 
@@ -175,50 +177,10 @@ func (r *RootApp) Run() error {
 		return err
 	}
 
-	buildTags := strings.Split(*r.Config.BuildTags, " ")
-
-	configuredPackages, err := r.Config.GetPackages(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get package from config: %w", err)
-	}
-	if len(configuredPackages) == 0 {
-		log.Error().Msg("no packages specified in config")
-		return fmt.Errorf("no packages specified in config")
-	}
-	parser := pkg.NewParser(buildTags)
-
-	// Let's build a missing map here to keep track of seen interfaces.
-	// (pkg -> list of interface names)
-	// After seeing an interface it'll be deleted from the map, keeping only
-	// missing interfaces or packages in there.
-	//
-	// NOTE: We do that here without relying on parser, because parses iterates
-	// over existing go files and interfaces, while user could've had a typo in
-	// interface or pacakge name, making it impossible for parser to find these
-	// files/interfaces in the first place.
-	log.Debug().Msg("Making seen map...")
-	missingMap := make(map[string]map[string]struct{}, len(configuredPackages))
-	for _, p := range configuredPackages {
-		config, err := r.Config.GetPackageConfig(ctx, p)
-		if err != nil {
-			return err
-		}
-		if _, ok := missingMap[p]; !ok {
-			missingMap[p] = make(map[string]struct{}, len(config.Interfaces))
-		}
-
-		for ifaceName := range config.Interfaces {
-			missingMap[p][ifaceName] = struct{}{}
-		}
-	}
-
-	log.Info().Msg("Parsing configured packages...")
-	interfaces, err := parser.ParsePackages(ctx, configuredPackages)
+	mocks, missingMap, err := r.resolveMocks(ctx)
 	if err != nil {
-		log.Error().Err(err).Msg("unable to parse packages")
 		return err
 	}
-	log.Info().Msg("Done parsing configured packages.")
 	// maps the following:
 	// outputFilePath|fullyQualifiedInterfaceName|[]*pkg.Interface
 	// The reason why we need an interior map of fully qualified interface name
@@ -227,71 +189,29 @@ func (r *RootApp) Run() error {
 	// be created for each input interface.
 	mockFileToInterfaces := map[string]*InterfaceCollection{}
 
-	for _, iface := range interfaces {
-		ifaceLog := log.
-			With().
+	for _, mock := range mocks {
+		iface := mock.iface
+		ifaceConfig := iface.Config
+		filePath := ifaceConfig.FilePath().Clean()
+		log.Info().
 			Str(logging.LogKeyInterface, iface.Name).
 			Str(logging.LogKeyPackagePath, iface.Pkg.Types.Path()).
-			Logger()
-
-		if _, exist := missingMap[iface.Pkg.PkgPath]; exist {
-			delete(missingMap[iface.Pkg.PkgPath], iface.Name)
-
-			if len(missingMap[iface.Pkg.PkgPath]) == 0 {
-				delete(missingMap, iface.Pkg.PkgPath)
-			}
-		}
-
-		ifaceCtx := ifaceLog.WithContext(ctx)
-
-		pkgConfig, err := r.Config.GetPackageConfig(ctx, iface.Pkg.PkgPath)
-		if err != nil {
-			return fmt.Errorf("getting package %s: %w", iface.Pkg.PkgPath, err)
+			Str("collection", filePath.String()).
+			Msg("adding interface to collection")
+
+		_, ok := mockFileToInterfaces[filePath.String()]
+		if !ok {
+			mockFileToInterfaces[filePath.String()] = NewInterfaceCollection(
+				iface.Pkg.PkgPath,
+				filePath,
+				iface.Pkg,
+				*ifaceConfig.PkgName,
+				*ifaceConfig.Template,
+			)
 		}
-		ifaceLog.Debug().Str("root-mock-name", *r.Config.Config.StructName).Str("pkg-mock-name", *pkgConfig.Config.StructName).Msg("mock-name during first GetPackageConfig")
-
-		shouldGenerate, err := pkgConfig.ShouldGenerateInterface(ifaceCtx, iface.Name)
-		if err != nil {
+		if err := mockFileToInterfaces[filePath.String()].Append(ctx, iface); err != nil {
 			return err
 		}
-		if !shouldGenerate {
-			ifaceLog.Debug().Msg("config doesn't specify to generate this interface, skipping")
-			continue
-		}
-		if pkgConfig.Interfaces == nil {
-			ifaceLog.Debug().Msg("interfaces is nil")
-		}
-		ifaceConfig := pkgConfig.GetInterfaceConfig(ctx, iface.Name)
-		for _, ifaceConfig := range ifaceConfig.Configs {
-			if err := ifaceConfig.ParseTemplates(ifaceCtx, iface, iface.Pkg); err != nil {
-				log.Err(err).Msg("Can't parse config templates for interface")
-				return err
-			}
-			filePath := ifaceConfig.FilePath().Clean()
-			ifaceLog.Info().Str("collection", filePath.String()).Msg("adding interface to collection")
-
-			_, ok := mockFileToInterfaces[filePath.String()]
-			if !ok {
-				mockFileToInterfaces[filePath.String()] = NewInterfaceCollection(
-					iface.Pkg.PkgPath,
-					filePath,
-					iface.Pkg,
-					*ifaceConfig.PkgName,
-					*ifaceConfig.Template,
-				)
-			}
-			if err := mockFileToInterfaces[filePath.String()].Append(
-				ctx,
-				config.NewInterface(
-					iface.Name,
-					iface.FileName,
-					iface.File,
-					iface.Pkg,
-					ifaceConfig),
-			); err != nil {
-				return err
-			}
-		}
 	}
 
 	for outFilePath, interfacesInFile := range mockFileToInterfaces {
@@ -368,3 +288,152 @@ func (r *RootApp) Run() error {
 
 	return nil
 }
+
+// resolvedMock is a single mock that mockery will generate: an interface along
+// with one of its fully resolved configs.
+type resolvedMock struct {
+	// iface holds the interface, with Config set to the resolved config.
+	iface *config.Interface
+	// configIdx is the index of the config in the interface's `configs` list.
+	configIdx int
+}
+
+// resolvedInterface is an interface found in one of the configured packages.
+type resolvedInterface struct {
+	// iface holds the interface. Its Config is always nil.
+	iface *config.Interface
+	// selected is whether the config specifies the interface to be generated.
+	selected bool
+	// reason is the reason why the interface was or was not selected.
+	reason config.SelectionReason
+	// mocks is the list of mocks to generate for the interface. It's empty if
+	// the interface is not selected.
+	mocks []resolvedMock
+}
+
+// resolveMocks parses the configured packages and resolves the config of every
+// mock that should be generated.
+func (r *RootApp) resolveMocks(ctx context.Context) ([]resolvedMock, map[string]map[string]struct{}, error) {
+	interfaces, missingMap, err := r.resolveInterfaces(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	mocks := []resolvedMock{}
+	for _, iface := range interfaces {
+		mocks = append(mocks, iface.mocks...)
+	}
+	return mocks, missingMap, nil
+}
+
+// resolveInterfaces parses the configured packages and returns every interface
+// found in them, along with the resolved config of each mock that should be
+// generated. It also returns the interfaces that were listed in the config but
+// not found in the source (package path -> interface names).
+func (r *RootApp) resolveInterfaces(ctx context.Context) ([]resolvedInterface, map[string]map[string]struct{}, error) {
+	log := zerolog.Ctx(ctx)
+	buildTags := strings.Split(*r.Config.BuildTags, " ")
+
+	configuredPackages, err := r.Config.GetPackages(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get package from config: %w", err)
+	}
+	if len(configuredPackages) == 0 {
+		log.Error().Msg("no packages specified in config")
+		return nil, nil, fmt.Errorf("no packages specified in config")
+	}
+	parser := pkg.NewParser(buildTags)
+
+	// Let's build a missing map here to keep track of seen interfaces.
+	// (pkg -> list of interface names)
+	// After seeing an interface it'll be deleted from the map, keeping only
+	// missing interfaces or packages in there.
+	//
+	// NOTE: We do that here without relying on parser, because parses iterates
+	// over existing go files and interfaces, while user could've had a typo in
+	// interface or pacakge name, making it impossible for parser to find these
+	// files/interfaces in the first place.
+	log.Debug().Msg("Making seen map...")
+	missingMap := make(map[string]map[string]struct{}, len(configuredPackages))
+	for _, p := range configuredPackages {
+		config, err := r.Config.GetPackageConfig(ctx, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := missingMap[p]; !ok {
+			missingMap[p] = make(map[string]struct{}, len(config.Interfaces))
+		}
+
+		for ifaceName := range config.Interfaces {
+			missingMap[p][ifaceName] = struct{}{}
+		}
+	}
+
+	log.Info().Msg("Parsing configured packages...")
+	interfaces, err := parser.ParsePackages(ctx, configuredPackages)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to parse packages")
+		return nil, nil, err
+	}
+	log.Info().Msg("Done parsing configured packages.")
+
+	resolved := []resolvedInterface{}
+	for _, iface := range interfaces {
+		ifaceLog := log.
+			With().
+			Str(logging.LogKeyInterface, iface.Name).
+			Str(logging.LogKeyPackagePath, iface.Pkg.Types.Path()).
+			Logger()
+
+		if _, exist := missingMap[iface.Pkg.PkgPath]; exist {
+			delete(missingMap[iface.Pkg.PkgPath], iface.Name)
+
+			if len(missingMap[iface.Pkg.PkgPath]) == 0 {
+				delete(missingMap, iface.Pkg.PkgPath)
+			}
+		}
+
+		ifaceCtx := ifaceLog.WithContext(ctx)
+
+		pkgConfig, err := r.Config.GetPackageConfig(ctx, iface.Pkg.PkgPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting package %s: %w", iface.Pkg.PkgPath, err)
+		}
+		ifaceLog.Debug().Str("root-mock-name", *r.Config.Config.StructName).Str("pkg-mock-name", *pkgConfig.Config.StructName).Msg("mock-name during first GetPackageConfig")
+
+		shouldGenerate, reason, err := pkgConfig.InterfaceSelection(ifaceCtx, iface.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		resolvedIface := resolvedInterface{
+			iface:    iface,
+			selected: shouldGenerate,
+			reason:   reason,
+		}
+		if !shouldGenerate {
+			ifaceLog.Debug().Msg("config doesn't specify to generate this interface, skipping")
+			resolved = append(resolved, resolvedIface)
+			continue
+		}
+		if pkgConfig.Interfaces == nil {
+			ifaceLog.Debug().Msg("interfaces is nil")
+		}
+		ifaceConfig := pkgConfig.GetInterfaceConfig(ctx, iface.Name)
+		for configIdx, ifaceConfig := range ifaceConfig.Configs {
+			if err := ifaceConfig.ParseTemplates(ifaceCtx, iface, iface.Pkg); err != nil {
+				log.Err(err).Msg("Can't parse config templates for interface")
+				return nil, nil, err
+			}
+			resolvedIface.mocks = append(resolvedIface.mocks, resolvedMock{
+				iface: config.NewInterface(
+					iface.Name,
+					iface.FileName,
+					iface.File,
+					iface.Pkg,
+					ifaceConfig),
+				configIdx: configIdx,
+			})
+		}
+		resolved = append(resolved, resolvedIface)
+	}
+	return resolved, missingMap, nil
+}
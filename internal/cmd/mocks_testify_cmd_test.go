@@ -6,6 +6,8 @@
 package cmd
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -89,3 +91,43 @@ func (_c *mockargGetter_GetString_Call) RunAndReturn(run func(name string) (stri
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *mockargGetter_GetString_Call) Once() *mockargGetter_GetString_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) Twice() *mockargGetter_GetString_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) Times(i int) *mockargGetter_GetString_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) Maybe() *mockargGetter_GetString_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) After(d time.Duration) *mockargGetter_GetString_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) WaitUntil(w <-chan time.Time) *mockargGetter_GetString_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) NotBefore(calls ...*mock.Call) *mockargGetter_GetString_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockargGetter_GetString_Call) Unset() *mockargGetter_GetString_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -3,27 +3,58 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	koanfYAML "github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/structs"
 	"github.com/knadh/koanf/v2"
+	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"github.com/vektra/mockery/v3/config"
 	"github.com/vektra/mockery/v3/internal/logging"
+	"gopkg.in/yaml.v3"
 )
 
 func NewShowConfigCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "showconfig",
 		Short: "Show the yaml config",
-		Long:  `Print out a yaml representation of the yaml config file. This does not show config from exterior sources like CLI, environment etc.`,
+		Long: `Print out a yaml representation of the yaml config file. This does not show config from exterior sources like CLI, environment etc.
+
+With --resolved, mockery parses the configured packages and prints the final config used for
+each mock, after all sources have been merged and templated values have been rendered. Each
+value is annotated with where it came from: default, env, flag, root, package, interface or
+configs[i].`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			log, err := logging.GetLogger("debug")
+			resolved, err := cmd.Flags().GetBool("resolved")
+			if err != nil {
+				return err
+			}
+			interfaceFilter, err := cmd.Flags().GetString("interface")
+			if err != nil {
+				return err
+			}
+			if interfaceFilter != "" && !resolved {
+				return fmt.Errorf("--interface can only be used with --resolved")
+			}
+
+			logLevel := "debug"
+			if resolved {
+				// The resolved config is printed to stdout, so keep the
+				// logs out of the way.
+				logLevel = "warn"
+			}
+			log, err := logging.GetLogger(logLevel)
 			if err != nil {
 				return err
 			}
 
 			ctx := log.WithContext(context.Background())
+			if resolved {
+				return showResolvedConfig(ctx, cmd, interfaceFilter)
+			}
 			conf, _, err := config.NewRootConfig(ctx, cmd.Parent().PersistentFlags())
 			if err != nil {
 				return err
@@ -40,4 +71,107 @@ func NewShowConfigCmd() *cobra.Command {
 			return nil
 		},
 	}
+	flags := cmd.Flags()
+	flags.Bool("resolved", false, "print the fully resolved config of every mock, annotated with where each value came from")
+	flags.String("interface", "", "only show the resolved config of the given interface, in the form of `pkg/path.Interface`")
+	return cmd
+}
+
+func showResolvedConfig(ctx context.Context, cmd *cobra.Command, interfaceFilter string) error {
+	log := zerolog.Ctx(ctx)
+
+	var filterPkgPath, filterIfaceName string
+	if interfaceFilter != "" {
+		idx := strings.LastIndex(interfaceFilter, ".")
+		if idx <= 0 || idx == len(interfaceFilter)-1 {
+			return fmt.Errorf("--interface must be in the form of pkg/path.Interface, got %q", interfaceFilter)
+		}
+		filterPkgPath, filterIfaceName = interfaceFilter[:idx], interfaceFilter[idx+1:]
+	}
+
+	r, err := GetRootApp(ctx, cmd.Root().PersistentFlags())
+	if err != nil {
+		return err
+	}
+	mocks, _, err := r.resolveMocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent(2)
+	defer encoder.Close()
+
+	found := false
+	for _, mock := range mocks {
+		pkgPath := mock.iface.Pkg.PkgPath
+		if interfaceFilter != "" && (pkgPath != filterPkgPath || mock.iface.Name != filterIfaceName) {
+			continue
+		}
+		found = true
+		provenance := r.Config.Provenance(pkgPath, mock.iface.Name, mock.configIdx)
+		if err := encodeResolvedConfig(encoder, mock, provenance); err != nil {
+			return err
+		}
+	}
+	if interfaceFilter != "" && !found {
+		log.Error().Str("interface", interfaceFilter).Msg("interface is not configured to be generated")
+		return fmt.Errorf("no mocks found for %s", interfaceFilter)
+	}
+	return nil
+}
+
+// encodeResolvedConfig encodes the config of a single mock as a yaml document.
+// Each parameter has a line comment describing where its value came from.
+func encodeResolvedConfig(encoder *yaml.Encoder, mock resolvedMock, provenance map[string]config.Source) error {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+
+	keys := []string{}
+	values := map[string]reflect.Value{}
+	confValue := reflect.ValueOf(mock.iface.Config).Elem()
+	confType := confValue.Type()
+	for i := 0; i < confType.NumField(); i++ {
+		key, _, _ := strings.Cut(confType.Field(i).Tag.Get("koanf"), ",")
+		fieldValue := confValue.Field(i)
+		if key == "_anchors" {
+			continue
+		}
+		if (fieldValue.Kind() == reflect.Pointer || fieldValue.Kind() == reflect.Map || fieldValue.Kind() == reflect.Slice) && fieldValue.IsNil() {
+			continue
+		}
+		keys = append(keys, key)
+		values[key] = fieldValue
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyNode := &yaml.Node{Kind: yaml.ScalarNode, Value: key}
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(values[key].Interface()); err != nil {
+			return fmt.Errorf("encoding %s: %w", key, err)
+		}
+		if valueNode.Kind == yaml.ScalarNode {
+			valueNode.LineComment = string(provenance[key])
+		} else {
+			keyNode.LineComment = string(provenance[key])
+		}
+		if _, isSchemaless := values[key].Interface().(map[string]any); isSchemaless && valueNode.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(valueNode.Content); i += 2 {
+				subKeyNode, subValueNode := valueNode.Content[i], valueNode.Content[i+1]
+				source := string(provenance[key+"."+subKeyNode.Value])
+				if subValueNode.Kind == yaml.ScalarNode {
+					subValueNode.LineComment = source
+				} else {
+					subKeyNode.LineComment = source
+				}
+			}
+		}
+		doc.Content = append(doc.Content, keyNode, valueNode)
+	}
+	doc.HeadComment = fmt.Sprintf("%s.%s configs[%d]", mock.iface.Pkg.PkgPath, mock.iface.Name, mock.configIdx)
+
+	if err := encoder.Encode(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{doc}}); err != nil {
+		return fmt.Errorf("encoding resolved config: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,9 @@
+package test
+
+import "context"
+
+type Authenticator interface {
+	Login(ctx context.Context, user string, secret string) (token string, err error)
+	Logout(ctx context.Context, token string) error
+	Ping(n int)
+}
@@ -6,6 +6,8 @@
 package comment
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -94,3 +96,43 @@ func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) RunAndReturn(run func(for
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) Once() *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) Twice() *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) Times(i int) *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) Maybe() *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) After(d time.Duration) *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockIfaceWithBuildTagInComment_Sprintf_Call) Unset() *MockIfaceWithBuildTagInComment_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -0,0 +1,36 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConformanceSuite(t *testing.T) {
+	RunAuthenticatorSuite(t, func() Authenticator { return fakeAuthenticator{} })
+	RunAuthenticatorSuite(t, func() Authenticator { return &StubAuthenticator{} })
+	RunRequesterVariadicSuite(t, func() RequesterVariadic { return &StubRequesterVariadic{} })
+}
+
+func TestConformanceSuiteWithChecks(t *testing.T) {
+	var called []string
+	RunAuthenticatorSuiteWith(t, func() Authenticator { return fakeAuthenticator{} }, AuthenticatorSuiteChecks{
+		Login: func(t *testing.T, impl Authenticator, ctx context.Context, user string, secret string, token string, err error) {
+			called = append(called, "Login")
+			assert.Equal(t, "sample", user)
+			assert.EqualError(t, err, "bad credentials")
+		},
+		Ping: func(t *testing.T, impl Authenticator, n int) {
+			called = append(called, "Ping")
+			assert.Equal(t, 1, n)
+		},
+	})
+	assert.Equal(t, []string{"Login", "Ping"}, called)
+
+	RunRequesterVariadicSuiteWith(t, func() RequesterVariadic { return &StubRequesterVariadic{} }, RequesterVariadicSuiteChecks{
+		Get: func(t *testing.T, impl RequesterVariadic, values []string, ret bool) {
+			assert.Equal(t, []string{"sample"}, values)
+		},
+	})
+}
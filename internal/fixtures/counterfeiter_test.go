@@ -0,0 +1,60 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterfeiterRequester(t *testing.T) {
+	fake := &FakeRequester{}
+	fake.GetReturns("bar", nil)
+	fake.GetReturnsOnCall(1, "", errors.New("failed"))
+
+	retString, err := fake.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", retString)
+	_, err = fake.Get("baz")
+	assert.EqualError(t, err, "failed")
+
+	assert.Equal(t, 2, fake.GetCallCount())
+	assert.Equal(t, "foo", fake.GetArgsForCall(0))
+	assert.Equal(t, "baz", fake.GetArgsForCall(1))
+	assert.Equal(t, map[string][][]interface{}{
+		"Get": {{"foo"}, {"baz"}},
+	}, fake.Invocations())
+}
+
+func TestCounterfeiterRequesterStub(t *testing.T) {
+	fake := &FakeRequester{}
+	fake.GetCalls(func(path string) (string, error) {
+		return path + " world", nil
+	})
+	retString, err := fake.Get("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", retString)
+}
+
+func TestCounterfeiterRequesterVariadic(t *testing.T) {
+	fake := &FakeRequesterVariadic{}
+	fake.SprintfReturns("foo 1")
+	values := []string{"a", "b"}
+
+	assert.Equal(t, "foo 1", fake.Sprintf("%s %d", "foo", 1))
+	assert.False(t, fake.Get(values...))
+	values[0] = "changed"
+
+	format, args := fake.SprintfArgsForCall(0)
+	assert.Equal(t, "%s %d", format)
+	assert.Equal(t, []interface{}{"foo", 1}, args)
+	assert.Equal(t, []string{"a", "b"}, fake.GetArgsForCall(0))
+}
+
+func TestCounterfeiterGeneric(t *testing.T) {
+	fake := &FakeGenericInterface[string]{}
+	fake.FuncReturns(5)
+	arg := "hello"
+	assert.Equal(t, 5, fake.Func(&arg))
+	assert.Equal(t, &arg, fake.FuncArgsForCall(0))
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) Login(ctx context.Context, user string, secret string) (string, error) {
+	if secret != "hunter2" {
+		return "", errors.New("bad credentials")
+	}
+	return "token-" + user, nil
+}
+
+func (fakeAuthenticator) Logout(ctx context.Context, token string) error {
+	return nil
+}
+
+func (fakeAuthenticator) Ping(n int) {}
+
+func TestSlogDecorator(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	auth := NewSlogAuthenticator(fakeAuthenticator{}, logger)
+
+	token, err := auth.Login(context.Background(), "alice", "hunter2")
+	require.NoError(t, err)
+	assert.Equal(t, "token-alice", token)
+	_, err = auth.Login(context.Background(), "bob", "wrong")
+	assert.EqualError(t, err, "bad credentials")
+	auth.Ping(3)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	records := make([]map[string]any, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &records[i]))
+	}
+
+	assert.Equal(t, "INFO", records[0]["level"])
+	assert.Equal(t, "Authenticator.Login", records[0]["msg"])
+	assert.Equal(t, "alice", records[0]["user"])
+	assert.Equal(t, "[REDACTED]", records[0]["secret"])
+	assert.Contains(t, records[0], "duration")
+	assert.NotContains(t, records[0], "ctx")
+
+	assert.Equal(t, "ERROR", records[1]["level"])
+	assert.Equal(t, "bad credentials", records[1]["error"])
+	assert.Equal(t, "[REDACTED]", records[1]["secret"])
+
+	assert.Equal(t, "Authenticator.Ping", records[2]["msg"])
+	assert.Equal(t, float64(3), records[2]["n"])
+}
@@ -6,6 +6,8 @@
 package empty_return
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -69,6 +71,46 @@ func (_c *MockEmptyReturn_NoArgs_Call) RunAndReturn(run func()) *MockEmptyReturn
 	return _c
 }
 
+func (_c *MockEmptyReturn_NoArgs_Call) Once() *MockEmptyReturn_NoArgs_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) Twice() *MockEmptyReturn_NoArgs_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) Times(i int) *MockEmptyReturn_NoArgs_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) Maybe() *MockEmptyReturn_NoArgs_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) After(d time.Duration) *MockEmptyReturn_NoArgs_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) WaitUntil(w <-chan time.Time) *MockEmptyReturn_NoArgs_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) NotBefore(calls ...*mock.Call) *MockEmptyReturn_NoArgs_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockEmptyReturn_NoArgs_Call) Unset() *MockEmptyReturn_NoArgs_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // WithArgs provides a mock function for the type MockEmptyReturn
 func (_mock *MockEmptyReturn) WithArgs(a int, b string) {
 	_mock.Called(a, b)
@@ -103,3 +145,43 @@ func (_c *MockEmptyReturn_WithArgs_Call) RunAndReturn(run func(a int, b string))
 	_c.Run(run)
 	return _c
 }
+
+func (_c *MockEmptyReturn_WithArgs_Call) Once() *MockEmptyReturn_WithArgs_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) Twice() *MockEmptyReturn_WithArgs_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) Times(i int) *MockEmptyReturn_WithArgs_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) Maybe() *MockEmptyReturn_WithArgs_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) After(d time.Duration) *MockEmptyReturn_WithArgs_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) WaitUntil(w <-chan time.Time) *MockEmptyReturn_WithArgs_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) NotBefore(calls ...*mock.Call) *MockEmptyReturn_WithArgs_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockEmptyReturn_WithArgs_Call) Unset() *MockEmptyReturn_WithArgs_Call {
+	_c.Call.Unset()
+	return _c
+}
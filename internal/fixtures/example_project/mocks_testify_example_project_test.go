@@ -6,6 +6,8 @@
 package example_project
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 	"github.com/vektra/mockery/v3/internal/fixtures/example_project/foo"
 )
@@ -92,6 +94,46 @@ func (_c *MockRoot_ReturnsFoo_Call) RunAndReturn(run func() (foo.Foo, error)) *M
 	return _c
 }
 
+func (_c *MockRoot_ReturnsFoo_Call) Once() *MockRoot_ReturnsFoo_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) Twice() *MockRoot_ReturnsFoo_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) Times(i int) *MockRoot_ReturnsFoo_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) Maybe() *MockRoot_ReturnsFoo_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) After(d time.Duration) *MockRoot_ReturnsFoo_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) WaitUntil(w <-chan time.Time) *MockRoot_ReturnsFoo_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) NotBefore(calls ...*mock.Call) *MockRoot_ReturnsFoo_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRoot_ReturnsFoo_Call) Unset() *MockRoot_ReturnsFoo_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // TakesBaz provides a mock function for the type MockRoot
 func (_mock *MockRoot) TakesBaz(baz *foo.Baz) {
 	_mock.Called(baz)
@@ -126,6 +168,46 @@ func (_c *MockRoot_TakesBaz_Call) RunAndReturn(run func(baz *foo.Baz)) *MockRoot
 	return _c
 }
 
+func (_c *MockRoot_TakesBaz_Call) Once() *MockRoot_TakesBaz_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) Twice() *MockRoot_TakesBaz_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) Times(i int) *MockRoot_TakesBaz_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) Maybe() *MockRoot_TakesBaz_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) After(d time.Duration) *MockRoot_TakesBaz_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) WaitUntil(w <-chan time.Time) *MockRoot_TakesBaz_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) NotBefore(calls ...*mock.Call) *MockRoot_TakesBaz_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRoot_TakesBaz_Call) Unset() *MockRoot_TakesBaz_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockStringer creates a new instance of MockStringer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStringer(t interface {
@@ -196,3 +278,43 @@ func (_c *MockStringer_String_Call) RunAndReturn(run func() string) *MockStringe
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockStringer_String_Call) Once() *MockStringer_String_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockStringer_String_Call) Twice() *MockStringer_String_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockStringer_String_Call) Times(i int) *MockStringer_String_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockStringer_String_Call) Maybe() *MockStringer_String_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockStringer_String_Call) After(d time.Duration) *MockStringer_String_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockStringer_String_Call) WaitUntil(w <-chan time.Time) *MockStringer_String_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockStringer_String_Call) NotBefore(calls ...*mock.Call) *MockStringer_String_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockStringer_String_Call) Unset() *MockStringer_String_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -6,6 +6,8 @@
 package replace_type
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 	"github.com/vektra/mockery/v3/internal/fixtures/example_project/replace_type/rti/rt1"
 	"github.com/vektra/mockery/v3/internal/fixtures/example_project/replace_type/rti/rt2"
@@ -72,6 +74,46 @@ func (_c *MockRType_Replace1_Call) RunAndReturn(run func(f rt1.RType1)) *MockRTy
 	return _c
 }
 
+func (_c *MockRType_Replace1_Call) Once() *MockRType_Replace1_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) Twice() *MockRType_Replace1_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) Times(i int) *MockRType_Replace1_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) Maybe() *MockRType_Replace1_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) After(d time.Duration) *MockRType_Replace1_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) WaitUntil(w <-chan time.Time) *MockRType_Replace1_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) NotBefore(calls ...*mock.Call) *MockRType_Replace1_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRType_Replace1_Call) Unset() *MockRType_Replace1_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Replace2 provides a mock function for the type MockRType
 func (_mock *MockRType) Replace2(f rt2.RType2) {
 	_mock.Called(f)
@@ -106,6 +148,46 @@ func (_c *MockRType_Replace2_Call) RunAndReturn(run func(f rt2.RType2)) *MockRTy
 	return _c
 }
 
+func (_c *MockRType_Replace2_Call) Once() *MockRType_Replace2_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) Twice() *MockRType_Replace2_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) Times(i int) *MockRType_Replace2_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) Maybe() *MockRType_Replace2_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) After(d time.Duration) *MockRType_Replace2_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) WaitUntil(w <-chan time.Time) *MockRType_Replace2_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) NotBefore(calls ...*mock.Call) *MockRType_Replace2_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRType_Replace2_Call) Unset() *MockRType_Replace2_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewRTypeReplaced1 creates a new instance of RTypeReplaced1. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRTypeReplaced1(t interface {
@@ -167,6 +249,46 @@ func (_c *RTypeReplaced1_Replace1_Call) RunAndReturn(run func(f rt2.RType2)) *RT
 	return _c
 }
 
+func (_c *RTypeReplaced1_Replace1_Call) Once() *RTypeReplaced1_Replace1_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) Twice() *RTypeReplaced1_Replace1_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) Times(i int) *RTypeReplaced1_Replace1_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) Maybe() *RTypeReplaced1_Replace1_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) After(d time.Duration) *RTypeReplaced1_Replace1_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) WaitUntil(w <-chan time.Time) *RTypeReplaced1_Replace1_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) NotBefore(calls ...*mock.Call) *RTypeReplaced1_Replace1_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace1_Call) Unset() *RTypeReplaced1_Replace1_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Replace2 provides a mock function for the type RTypeReplaced1
 func (_mock *RTypeReplaced1) Replace2(f rt2.RType2) {
 	_mock.Called(f)
@@ -200,3 +322,43 @@ func (_c *RTypeReplaced1_Replace2_Call) RunAndReturn(run func(f rt2.RType2)) *RT
 	_c.Run(run)
 	return _c
 }
+
+func (_c *RTypeReplaced1_Replace2_Call) Once() *RTypeReplaced1_Replace2_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) Twice() *RTypeReplaced1_Replace2_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) Times(i int) *RTypeReplaced1_Replace2_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) Maybe() *RTypeReplaced1_Replace2_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) After(d time.Duration) *RTypeReplaced1_Replace2_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) WaitUntil(w <-chan time.Time) *RTypeReplaced1_Replace2_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) NotBefore(calls ...*mock.Call) *RTypeReplaced1_Replace2_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *RTypeReplaced1_Replace2_Call) Unset() *RTypeReplaced1_Replace2_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -0,0 +1,47 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektra/mockery/v3/fuzz"
+)
+
+// session logs in and out, and is the code under test of FuzzSession.
+func session(auth Authenticator) error {
+	token, err := auth.Login(context.Background(), "alice", "hunter2")
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return errors.New("empty token")
+	}
+	return auth.Logout(context.Background(), token)
+}
+
+func FuzzSession(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 1, 3})
+	f.Add([]byte{3, 'a', 'b', 'c', 0, 0})
+	f.Add([]byte{3, 'a', 'b', 'c', 0, 1, 5})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		err := session(NewFuzzAuthenticator(fuzz.NewSource(data)))
+		if err != nil && !errors.Is(err, fuzz.ErrFuzz) {
+			assert.EqualError(t, err, "empty token")
+		}
+	})
+}
+
+func TestFuzzFake(t *testing.T) {
+	src := fuzz.NewSource([]byte{3, 'a', 'b', 'c', 1, 7})
+	token, err := NewFuzzAuthenticator(src).Login(context.Background(), "alice", "hunter2")
+	assert.Equal(t, "abc", token)
+	assert.ErrorIs(t, err, fuzz.ErrFuzz)
+
+	src = fuzz.NewSource([]byte{2, 1, 'a', 2, 'b', 'c'})
+	paths, err := NewFuzzRequesterSlice(src).Get("path")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "bc"}, paths)
+}
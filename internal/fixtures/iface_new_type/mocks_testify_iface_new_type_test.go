@@ -6,6 +6,8 @@
 package iface_new_type
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -68,3 +70,43 @@ func (_c *MockInterface1_Method1_Call) RunAndReturn(run func()) *MockInterface1_
 	_c.Run(run)
 	return _c
 }
+
+func (_c *MockInterface1_Method1_Call) Once() *MockInterface1_Method1_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) Twice() *MockInterface1_Method1_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) Times(i int) *MockInterface1_Method1_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) Maybe() *MockInterface1_Method1_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) After(d time.Duration) *MockInterface1_Method1_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) WaitUntil(w <-chan time.Time) *MockInterface1_Method1_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) NotBefore(calls ...*mock.Call) *MockInterface1_Method1_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockInterface1_Method1_Call) Unset() *MockInterface1_Method1_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -0,0 +1,9 @@
+package iface_return
+
+import "io"
+
+// Opener returns an interface other than error, which can't be decoded from a
+// recording.
+type Opener interface {
+	Open(path string) (io.Reader, error)
+}
@@ -7,6 +7,7 @@ package iface_typed_param
 
 import (
 	"io"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -83,3 +84,43 @@ func (_c *MockGetterIfaceTypedParam_Get_Call[T]) RunAndReturn(run func() T) *Moc
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) Once() *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) Twice() *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) Times(i int) *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) Maybe() *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) After(d time.Duration) *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) WaitUntil(w <-chan time.Time) *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) NotBefore(calls ...*mock.Call) *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockGetterIfaceTypedParam_Get_Call[T]) Unset() *MockGetterIfaceTypedParam_Get_Call[T] {
+	_c.Call.Unset()
+	return _c
+}
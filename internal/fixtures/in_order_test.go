@@ -0,0 +1,54 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/vektra/mockery/v3/sequence"
+)
+
+func TestInOrder(t *testing.T) {
+	ctx := context.Background()
+	auth := NewMockAuthenticatorInOrder(t)
+	requester := NewMockRequesterInOrder(t)
+
+	login := auth.EXPECT().Login(mock.Anything, "alice", mock.Anything).Return("token", nil).Once()
+	get := requester.EXPECT().Get("/profile").Return("alice", nil).Once()
+	logout := auth.EXPECT().Logout(mock.Anything, "token").Return(nil).Once()
+	sequence.InOrder(t, login, get, logout)
+
+	_, _ = auth.Login(ctx, "alice", "hunter2")
+	_, _ = requester.Get("/profile")
+	_ = auth.Logout(ctx, "token")
+}
+
+func TestInSequence(t *testing.T) {
+	var seq sequence.Sequence
+	ctx := context.Background()
+	auth := NewMockAuthenticatorInOrder(t)
+	auth.InSequence(&seq)
+	requester := NewMockRequesterInOrder(t)
+	requester.InSequence(&seq)
+	variadic := NewMockRequesterVariadicInOrder(t)
+	variadic.InSequence(&seq)
+
+	login := auth.EXPECT().Login(mock.Anything, "alice", mock.Anything).Return("token", nil)
+	get := requester.EXPECT().Get("/profile").Return("alice", nil)
+	sprintf := variadic.EXPECT().Sprintf("%s", "alice").Return("alice")
+	logout := auth.EXPECT().Logout(mock.Anything, "token").Return(nil)
+
+	_, _ = auth.Login(ctx, "alice", "hunter2")
+	_, _ = requester.Get("/profile")
+	variadic.Sprintf("%s", "alice")
+	_ = auth.Logout(ctx, "token")
+
+	assert.True(t, seq.AssertOrder(t, login, get, sprintf, logout))
+	assert.True(t, seq.AssertOrder(t, login, logout))
+	calls := seq.Calls()
+	if assert.Len(t, calls, 4) {
+		assert.Equal(t, "Sprintf", calls[2].Method)
+		assert.Equal(t, mock.Arguments{"%s", "alice"}, calls[2].Arguments)
+	}
+}
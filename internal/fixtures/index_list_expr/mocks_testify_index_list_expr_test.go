@@ -6,6 +6,8 @@
 package index_list_expr
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -84,6 +86,46 @@ func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) RunAndReturn(run func(
 	return _c
 }
 
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) Once() *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) Twice() *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) Times(i int) *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) Maybe() *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) After(d time.Duration) *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) WaitUntil(w <-chan time.Time) *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) NotBefore(calls ...*mock.Call) *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockGenericMultipleTypes_Func_Call[T1, T2, T3]) Unset() *MockGenericMultipleTypes_Func_Call[T1, T2, T3] {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockIndexListExpr creates a new instance of MockIndexListExpr. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIndexListExpr(t interface {
@@ -156,3 +198,43 @@ func (_c *MockIndexListExpr_Func_Call) RunAndReturn(run func(arg1 *int, arg2 str
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockIndexListExpr_Func_Call) Once() *MockIndexListExpr_Func_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) Twice() *MockIndexListExpr_Func_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) Times(i int) *MockIndexListExpr_Func_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) Maybe() *MockIndexListExpr_Func_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) After(d time.Duration) *MockIndexListExpr_Func_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) WaitUntil(w <-chan time.Time) *MockIndexListExpr_Func_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) NotBefore(calls ...*mock.Call) *MockIndexListExpr_Func_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockIndexListExpr_Func_Call) Unset() *MockIndexListExpr_Func_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertT records the failures reported by the generated assertions.
+type assertT struct {
+	testing.TB
+	errors   []string
+	cleanups []func()
+}
+
+func (a *assertT) Helper() {}
+
+func (a *assertT) Errorf(format string, args ...any) {
+	a.errors = append(a.errors, fmt.Sprintf(format, args...))
+}
+
+func (a *assertT) Cleanup(fn func()) {
+	a.cleanups = append(a.cleanups, fn)
+}
+
+func (a *assertT) runCleanups() {
+	for _, cleanup := range a.cleanups {
+		cleanup()
+	}
+}
+
+func TestMatryerAssertCalledTimes(t *testing.T) {
+	m := &MoqRequesterAsserted{
+		GetFunc: func(path string) (string, error) { return path, nil },
+	}
+	_, _ = m.Get("/a")
+	_, _ = m.Get("/b")
+
+	assert.True(t, m.AssertGetCalledTimes(t, 2))
+
+	rt := &assertT{TB: t}
+	assert.False(t, m.AssertGetCalledTimes(rt, 1))
+	assert.Equal(t, []string{"MoqRequesterAsserted.Get: expected 1 calls, got 2"}, rt.errors)
+}
+
+func TestMatryerAssertCalledWith(t *testing.T) {
+	m := &MoqRequesterVariadicAsserted{}
+	m.Get("a", "b")
+
+	assert.True(t, m.AssertGetCalledWith(t, "a", "b"))
+
+	rt := &assertT{TB: t}
+	assert.False(t, m.AssertGetCalledWith(rt, "a"))
+	assert.Equal(t, []string{"MoqRequesterVariadicAsserted.Get: expected a call with {Values:[a]}, got [{Values:[a b]}]"}, rt.errors)
+}
+
+func TestMatryerAssertAllCalled(t *testing.T) {
+	m := &MoqRequesterAsserted{
+		GetFuncs: []func(path string) (string, error){
+			func(path string) (string, error) { return "first", nil },
+			func(path string) (string, error) { return "second", nil },
+		},
+	}
+	_, _ = m.Get("/a")
+
+	rt := &assertT{TB: t}
+	assert.False(t, m.AssertAllCalled(rt))
+	assert.Equal(t, []string{"MoqRequesterAsserted.Get: expected 2 calls to use GetFuncs, got 1"}, rt.errors)
+
+	_, _ = m.Get("/b")
+	assert.True(t, m.AssertAllCalled(t))
+}
+
+func TestMatryerAssertAllCalledUnusedFunc(t *testing.T) {
+	m := &MoqAuthenticatorAsserted{
+		LogoutFunc: func(ctx context.Context, token string) error { return nil },
+	}
+	m.Ping(1)
+
+	rt := &assertT{TB: t}
+	assert.False(t, m.AssertAllCalled(rt))
+	assert.Equal(t, []string{"MoqAuthenticatorAsserted.Logout: expected at least one call, got none"}, rt.errors)
+}
+
+func TestMatryerAssertedConstructor(t *testing.T) {
+	rt := &assertT{TB: t}
+	NewMoqAuthenticatorAsserted(rt)
+	rt.runCleanups()
+	assert.Equal(t, []string{"MoqAuthenticatorAsserted.Ping: expected at least one call, got none"}, rt.errors)
+
+	rt = &assertT{TB: t}
+	m := NewMoqAuthenticatorAsserted(rt)
+	m.LogoutFunc = func(ctx context.Context, token string) error { return nil }
+	m.Ping(1)
+	rt.runCleanups()
+	assert.Empty(t, rt.errors)
+	assert.True(t, m.AssertPingCalledWith(t, 1))
+	assert.True(t, m.AssertRequiredCalled(t))
+}
@@ -0,0 +1,3 @@
+package fake
+
+type Value string
@@ -0,0 +1,11 @@
+package fake_names
+
+import "github.com/vektra/mockery/v3/internal/fixtures/method_args/fake_names/fake"
+
+// FakeNames has a method and parameters named like the identifiers that
+// counterfeiter fakes declare, and uses a package named like the fake's
+// receiver.
+type FakeNames interface {
+	Invocations(stub string, i int) (fake.Value, error)
+	Get(result1 fake.Value) fake.Value
+}
@@ -0,0 +1,32 @@
+package fake_names
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektra/mockery/v3/internal/fixtures/method_args/fake_names/fake"
+)
+
+func TestCounterfeiterFakeNames(t *testing.T) {
+	f := &FakeFakeNames{}
+	f.InvocationsReturns("a", nil)
+	f.InvocationsReturnsOnCall(1, "", errors.New("failed"))
+	f.GetCalls(func(v fake.Value) fake.Value { return v + "!" })
+
+	got, err := f.Invocations("stub", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, fake.Value("a"), got)
+	_, err = f.Invocations("stub", 2)
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, fake.Value("b!"), f.Get("b"))
+
+	assert.Equal(t, 2, f.InvocationsCallCount())
+	stub, i := f.InvocationsArgsForCall(1)
+	assert.Equal(t, "stub", stub)
+	assert.Equal(t, 2, i)
+	assert.Equal(t, map[string][][]interface{}{
+		"Invocations": {{"stub", 1}, {"stub", 2}},
+		"Get":         {{fake.Value("b")}},
+	}, f.Invocations1())
+}
@@ -0,0 +1,7 @@
+package ret_names
+
+// RetNames has parameters named like the locals that mocks use for return
+// values.
+type RetNames interface {
+	Get(r0 string, r1 int) (string, error)
+}
@@ -0,0 +1,27 @@
+package ret_names
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektra/mockery/v3/recording"
+)
+
+func TestReplayingRetNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ret_names.golden.json")
+
+	recorder := recording.NewRecorder(path)
+	recorder.Record("Get", []any{"a", 1}, []any{"b", recording.EncodeError(nil)})
+	recorder.Record("Get", []any{"c", 2}, []any{"", recording.EncodeError(errors.New("not found"))})
+	require.NoError(t, recorder.Save())
+
+	replayed := NewReplayingRetNames(t, path)
+	got, err := replayed.Get("a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got)
+	_, err = replayed.Get("c", 2)
+	assert.EqualError(t, err, "not found")
+}
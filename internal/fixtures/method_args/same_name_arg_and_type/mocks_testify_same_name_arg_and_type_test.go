@@ -6,6 +6,8 @@
 package same_name_arg_and_type
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -83,6 +85,46 @@ func (_c *mockinterfaceA_DoB_Call) RunAndReturn(run func(interfaceB1 interfaceB)
 	return _c
 }
 
+func (_c *mockinterfaceA_DoB_Call) Once() *mockinterfaceA_DoB_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) Twice() *mockinterfaceA_DoB_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) Times(i int) *mockinterfaceA_DoB_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) Maybe() *mockinterfaceA_DoB_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) After(d time.Duration) *mockinterfaceA_DoB_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) WaitUntil(w <-chan time.Time) *mockinterfaceA_DoB_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) NotBefore(calls ...*mock.Call) *mockinterfaceA_DoB_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB_Call) Unset() *mockinterfaceA_DoB_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // DoB0 provides a mock function for the type mockinterfaceA
 func (_mock *mockinterfaceA) DoB0(interfaceB interfaceB0) interfaceB0 {
 	ret := _mock.Called(interfaceB)
@@ -130,6 +172,46 @@ func (_c *mockinterfaceA_DoB0_Call) RunAndReturn(run func(interfaceB interfaceB0
 	return _c
 }
 
+func (_c *mockinterfaceA_DoB0_Call) Once() *mockinterfaceA_DoB0_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) Twice() *mockinterfaceA_DoB0_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) Times(i int) *mockinterfaceA_DoB0_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) Maybe() *mockinterfaceA_DoB0_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) After(d time.Duration) *mockinterfaceA_DoB0_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) WaitUntil(w <-chan time.Time) *mockinterfaceA_DoB0_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) NotBefore(calls ...*mock.Call) *mockinterfaceA_DoB0_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0_Call) Unset() *mockinterfaceA_DoB0_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // DoB0v2 provides a mock function for the type mockinterfaceA
 func (_mock *mockinterfaceA) DoB0v2(interfaceB01 interfaceB0) interfaceB0 {
 	ret := _mock.Called(interfaceB01)
@@ -177,6 +259,46 @@ func (_c *mockinterfaceA_DoB0v2_Call) RunAndReturn(run func(interfaceB01 interfa
 	return _c
 }
 
+func (_c *mockinterfaceA_DoB0v2_Call) Once() *mockinterfaceA_DoB0v2_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) Twice() *mockinterfaceA_DoB0v2_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) Times(i int) *mockinterfaceA_DoB0v2_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) Maybe() *mockinterfaceA_DoB0v2_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) After(d time.Duration) *mockinterfaceA_DoB0v2_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) WaitUntil(w <-chan time.Time) *mockinterfaceA_DoB0v2_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) NotBefore(calls ...*mock.Call) *mockinterfaceA_DoB0v2_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockinterfaceA_DoB0v2_Call) Unset() *mockinterfaceA_DoB0v2_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // newMockinterfaceB creates a new instance of mockinterfaceB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockinterfaceB(t interface {
@@ -248,6 +370,46 @@ func (_c *mockinterfaceB_GetData_Call) RunAndReturn(run func() int) *mockinterfa
 	return _c
 }
 
+func (_c *mockinterfaceB_GetData_Call) Once() *mockinterfaceB_GetData_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) Twice() *mockinterfaceB_GetData_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) Times(i int) *mockinterfaceB_GetData_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) Maybe() *mockinterfaceB_GetData_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) After(d time.Duration) *mockinterfaceB_GetData_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) WaitUntil(w <-chan time.Time) *mockinterfaceB_GetData_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) NotBefore(calls ...*mock.Call) *mockinterfaceB_GetData_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockinterfaceB_GetData_Call) Unset() *mockinterfaceB_GetData_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // newMockinterfaceB0 creates a new instance of mockinterfaceB0. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockinterfaceB0(t interface {
@@ -321,3 +483,43 @@ func (_c *mockinterfaceB0_DoB0_Call) RunAndReturn(run func(interfaceB01 interfac
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *mockinterfaceB0_DoB0_Call) Once() *mockinterfaceB0_DoB0_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) Twice() *mockinterfaceB0_DoB0_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) Times(i int) *mockinterfaceB0_DoB0_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) Maybe() *mockinterfaceB0_DoB0_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) After(d time.Duration) *mockinterfaceB0_DoB0_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) WaitUntil(w <-chan time.Time) *mockinterfaceB0_DoB0_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) NotBefore(calls ...*mock.Call) *mockinterfaceB0_DoB0_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockinterfaceB0_DoB0_Call) Unset() *mockinterfaceB0_DoB0_Call {
+	_c.Call.Unset()
+	return _c
+}
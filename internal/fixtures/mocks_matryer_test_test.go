@@ -6,10 +6,14 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"sync"
+	"testing"
 	"unsafe"
 
 	http1 "github.com/vektra/mockery/v3/internal/fixtures/12345678/http"
@@ -551,6 +555,817 @@ func (mock *MoqAsyncProducer) ResetCalls() {
 	mock.lockWhatever.Unlock()
 }
 
+// Ensure that MoqAuthenticator does implement Authenticator.
+// If this is not the case, regenerate this file with mockery.
+var _ Authenticator = &MoqAuthenticator{}
+
+// MoqAuthenticator is a mock implementation of Authenticator.
+//
+//	func TestSomethingThatUsesAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked Authenticator
+//		mockedAuthenticator := &MoqAuthenticator{
+//			LoginFunc: func(ctx context.Context, user string, secret string) (string, error) {
+//				panic("mock out the Login method")
+//			},
+//			LogoutFunc: func(ctx context.Context, token string) error {
+//				panic("mock out the Logout method")
+//			},
+//			PingFunc: func(n int)  {
+//				panic("mock out the Ping method")
+//			},
+//		}
+//
+//		// use mockedAuthenticator in code that requires Authenticator
+//		// and then make assertions.
+//
+//	}
+type MoqAuthenticator struct {
+	// LoginFunc mocks the Login method.
+	LoginFunc func(ctx context.Context, user string, secret string) (string, error)
+
+	// LogoutFunc mocks the Logout method.
+	LogoutFunc func(ctx context.Context, token string) error
+
+	// PingFunc mocks the Ping method.
+	PingFunc func(n int)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Login holds details about calls to the Login method.
+		Login []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User string
+			// Secret is the secret argument value.
+			Secret string
+		}
+		// Logout holds details about calls to the Logout method.
+		Logout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// Ping holds details about calls to the Ping method.
+		Ping []struct {
+			// N is the n argument value.
+			N int
+		}
+	}
+	lockLogin  sync.RWMutex
+	lockLogout sync.RWMutex
+	lockPing   sync.RWMutex
+}
+
+// Login calls LoginFunc.
+func (mock *MoqAuthenticator) Login(ctx context.Context, user string, secret string) (string, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}{
+		Ctx:    ctx,
+		User:   user,
+		Secret: secret,
+	}
+	mock.lockLogin.Lock()
+	mock.calls.Login = append(mock.calls.Login, callInfo)
+	mock.lockLogin.Unlock()
+	if mock.LoginFunc == nil {
+		var (
+			token string
+			err   error
+		)
+		return token, err
+	}
+	return mock.LoginFunc(ctx, user, secret)
+}
+
+// LoginCalls gets all the calls that were made to Login.
+// Check the length with:
+//
+//	len(mockedAuthenticator.LoginCalls())
+func (mock *MoqAuthenticator) LoginCalls() []struct {
+	Ctx    context.Context
+	User   string
+	Secret string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}
+	mock.lockLogin.RLock()
+	calls = mock.calls.Login
+	mock.lockLogin.RUnlock()
+	return calls
+}
+
+// ResetLoginCalls reset all the calls that were made to Login.
+func (mock *MoqAuthenticator) ResetLoginCalls() {
+	mock.lockLogin.Lock()
+	mock.calls.Login = nil
+	mock.lockLogin.Unlock()
+}
+
+// Logout calls LogoutFunc.
+func (mock *MoqAuthenticator) Logout(ctx context.Context, token string) error {
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockLogout.Lock()
+	mock.calls.Logout = append(mock.calls.Logout, callInfo)
+	mock.lockLogout.Unlock()
+	if mock.LogoutFunc == nil {
+		var (
+			err error
+		)
+		return err
+	}
+	return mock.LogoutFunc(ctx, token)
+}
+
+// LogoutCalls gets all the calls that were made to Logout.
+// Check the length with:
+//
+//	len(mockedAuthenticator.LogoutCalls())
+func (mock *MoqAuthenticator) LogoutCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockLogout.RLock()
+	calls = mock.calls.Logout
+	mock.lockLogout.RUnlock()
+	return calls
+}
+
+// ResetLogoutCalls reset all the calls that were made to Logout.
+func (mock *MoqAuthenticator) ResetLogoutCalls() {
+	mock.lockLogout.Lock()
+	mock.calls.Logout = nil
+	mock.lockLogout.Unlock()
+}
+
+// Ping calls PingFunc.
+func (mock *MoqAuthenticator) Ping(n int) {
+	callInfo := struct {
+		N int
+	}{
+		N: n,
+	}
+	mock.lockPing.Lock()
+	mock.calls.Ping = append(mock.calls.Ping, callInfo)
+	mock.lockPing.Unlock()
+	if mock.PingFunc == nil {
+		return
+	}
+	mock.PingFunc(n)
+}
+
+// PingCalls gets all the calls that were made to Ping.
+// Check the length with:
+//
+//	len(mockedAuthenticator.PingCalls())
+func (mock *MoqAuthenticator) PingCalls() []struct {
+	N int
+} {
+	var calls []struct {
+		N int
+	}
+	mock.lockPing.RLock()
+	calls = mock.calls.Ping
+	mock.lockPing.RUnlock()
+	return calls
+}
+
+// ResetPingCalls reset all the calls that were made to Ping.
+func (mock *MoqAuthenticator) ResetPingCalls() {
+	mock.lockPing.Lock()
+	mock.calls.Ping = nil
+	mock.lockPing.Unlock()
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqAuthenticator) ResetCalls() {
+	mock.lockLogin.Lock()
+	mock.calls.Login = nil
+	mock.lockLogin.Unlock()
+
+	mock.lockLogout.Lock()
+	mock.calls.Logout = nil
+	mock.lockLogout.Unlock()
+
+	mock.lockPing.Lock()
+	mock.calls.Ping = nil
+	mock.lockPing.Unlock()
+}
+
+// Ensure that MoqAuthenticatorScripted does implement Authenticator.
+// If this is not the case, regenerate this file with mockery.
+var _ Authenticator = &MoqAuthenticatorScripted{}
+
+// MoqAuthenticatorScripted is a mock implementation of Authenticator.
+//
+//	func TestSomethingThatUsesAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked Authenticator
+//		mockedAuthenticator := &MoqAuthenticatorScripted{
+//			LoginFunc: func(ctx context.Context, user string, secret string) (string, error) {
+//				panic("mock out the Login method")
+//			},
+//			LogoutFunc: func(ctx context.Context, token string) error {
+//				panic("mock out the Logout method")
+//			},
+//			PingFunc: func(n int)  {
+//				panic("mock out the Ping method")
+//			},
+//		}
+//
+//		// use mockedAuthenticator in code that requires Authenticator
+//		// and then make assertions.
+//
+//	}
+type MoqAuthenticatorScripted struct {
+	// LoginFunc mocks the Login method.
+	LoginFunc func(ctx context.Context, user string, secret string) (string, error)
+
+	// LoginFuncs mocks the successive calls to the Login method: the
+	// first call is served by LoginFuncs[0], the second by LoginFuncs[1],
+	// and so on. Calls without an entry, or with a nil one, fall back to
+	// LoginFunc.
+	LoginFuncs []func(ctx context.Context, user string, secret string) (string, error)
+
+	// LogoutFunc mocks the Logout method.
+	LogoutFunc func(ctx context.Context, token string) error
+
+	// LogoutFuncs mocks the successive calls to the Logout method: the
+	// first call is served by LogoutFuncs[0], the second by LogoutFuncs[1],
+	// and so on. Calls without an entry, or with a nil one, fall back to
+	// LogoutFunc.
+	LogoutFuncs []func(ctx context.Context, token string) error
+
+	// PingFunc mocks the Ping method.
+	PingFunc func(n int)
+
+	// PingFuncs mocks the successive calls to the Ping method: the
+	// first call is served by PingFuncs[0], the second by PingFuncs[1],
+	// and so on. Calls without an entry, or with a nil one, fall back to
+	// PingFunc.
+	PingFuncs []func(n int)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Login holds details about calls to the Login method.
+		Login []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User string
+			// Secret is the secret argument value.
+			Secret string
+		}
+		// Logout holds details about calls to the Logout method.
+		Logout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// Ping holds details about calls to the Ping method.
+		Ping []struct {
+			// N is the n argument value.
+			N int
+		}
+	}
+	lockLogin  sync.RWMutex
+	lockLogout sync.RWMutex
+	lockPing   sync.RWMutex
+}
+
+// Login calls the entry of LoginFuncs for this call, or LoginFunc.
+func (mock *MoqAuthenticatorScripted) Login(ctx context.Context, user string, secret string) (string, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}{
+		Ctx:    ctx,
+		User:   user,
+		Secret: secret,
+	}
+	mock.lockLogin.Lock()
+	callIndex := len(mock.calls.Login)
+	mock.calls.Login = append(mock.calls.Login, callInfo)
+	mock.lockLogin.Unlock()
+	fn := mock.LoginFunc
+	if callIndex < len(mock.LoginFuncs) && mock.LoginFuncs[callIndex] != nil {
+		fn = mock.LoginFuncs[callIndex]
+	}
+	if fn == nil {
+		var (
+			token string
+			err   error
+		)
+		return token, err
+	}
+	return fn(ctx, user, secret)
+}
+
+// LoginCalls gets all the calls that were made to Login.
+// Check the length with:
+//
+//	len(mockedAuthenticator.LoginCalls())
+func (mock *MoqAuthenticatorScripted) LoginCalls() []struct {
+	Ctx    context.Context
+	User   string
+	Secret string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}
+	mock.lockLogin.RLock()
+	calls = mock.calls.Login
+	mock.lockLogin.RUnlock()
+	return calls
+}
+
+// ResetLoginCalls reset all the calls that were made to Login.
+func (mock *MoqAuthenticatorScripted) ResetLoginCalls() {
+	mock.lockLogin.Lock()
+	mock.calls.Login = nil
+	mock.lockLogin.Unlock()
+}
+
+// Logout calls the entry of LogoutFuncs for this call, or LogoutFunc.
+func (mock *MoqAuthenticatorScripted) Logout(ctx context.Context, token string) error {
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockLogout.Lock()
+	callIndex := len(mock.calls.Logout)
+	mock.calls.Logout = append(mock.calls.Logout, callInfo)
+	mock.lockLogout.Unlock()
+	fn := mock.LogoutFunc
+	if callIndex < len(mock.LogoutFuncs) && mock.LogoutFuncs[callIndex] != nil {
+		fn = mock.LogoutFuncs[callIndex]
+	}
+	if fn == nil {
+		var (
+			err error
+		)
+		return err
+	}
+	return fn(ctx, token)
+}
+
+// LogoutCalls gets all the calls that were made to Logout.
+// Check the length with:
+//
+//	len(mockedAuthenticator.LogoutCalls())
+func (mock *MoqAuthenticatorScripted) LogoutCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockLogout.RLock()
+	calls = mock.calls.Logout
+	mock.lockLogout.RUnlock()
+	return calls
+}
+
+// ResetLogoutCalls reset all the calls that were made to Logout.
+func (mock *MoqAuthenticatorScripted) ResetLogoutCalls() {
+	mock.lockLogout.Lock()
+	mock.calls.Logout = nil
+	mock.lockLogout.Unlock()
+}
+
+// Ping calls the entry of PingFuncs for this call, or PingFunc.
+func (mock *MoqAuthenticatorScripted) Ping(n int) {
+	callInfo := struct {
+		N int
+	}{
+		N: n,
+	}
+	mock.lockPing.Lock()
+	callIndex := len(mock.calls.Ping)
+	mock.calls.Ping = append(mock.calls.Ping, callInfo)
+	mock.lockPing.Unlock()
+	fn := mock.PingFunc
+	if callIndex < len(mock.PingFuncs) && mock.PingFuncs[callIndex] != nil {
+		fn = mock.PingFuncs[callIndex]
+	}
+	if fn == nil {
+		return
+	}
+	fn(n)
+}
+
+// PingCalls gets all the calls that were made to Ping.
+// Check the length with:
+//
+//	len(mockedAuthenticator.PingCalls())
+func (mock *MoqAuthenticatorScripted) PingCalls() []struct {
+	N int
+} {
+	var calls []struct {
+		N int
+	}
+	mock.lockPing.RLock()
+	calls = mock.calls.Ping
+	mock.lockPing.RUnlock()
+	return calls
+}
+
+// ResetPingCalls reset all the calls that were made to Ping.
+func (mock *MoqAuthenticatorScripted) ResetPingCalls() {
+	mock.lockPing.Lock()
+	mock.calls.Ping = nil
+	mock.lockPing.Unlock()
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqAuthenticatorScripted) ResetCalls() {
+	mock.lockLogin.Lock()
+	mock.calls.Login = nil
+	mock.lockLogin.Unlock()
+
+	mock.lockLogout.Lock()
+	mock.calls.Logout = nil
+	mock.lockLogout.Unlock()
+
+	mock.lockPing.Lock()
+	mock.calls.Ping = nil
+	mock.lockPing.Unlock()
+}
+
+// Ensure that MoqAuthenticatorAsserted does implement Authenticator.
+// If this is not the case, regenerate this file with mockery.
+var _ Authenticator = &MoqAuthenticatorAsserted{}
+
+// MoqAuthenticatorAsserted is a mock implementation of Authenticator.
+//
+//	func TestSomethingThatUsesAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked Authenticator
+//		mockedAuthenticator := &MoqAuthenticatorAsserted{
+//			LoginFunc: func(ctx context.Context, user string, secret string) (string, error) {
+//				panic("mock out the Login method")
+//			},
+//			LogoutFunc: func(ctx context.Context, token string) error {
+//				panic("mock out the Logout method")
+//			},
+//			PingFunc: func(n int)  {
+//				panic("mock out the Ping method")
+//			},
+//		}
+//
+//		// use mockedAuthenticator in code that requires Authenticator
+//		// and then make assertions.
+//
+//	}
+type MoqAuthenticatorAsserted struct {
+	// LoginFunc mocks the Login method.
+	LoginFunc func(ctx context.Context, user string, secret string) (string, error)
+
+	// LogoutFunc mocks the Logout method.
+	LogoutFunc func(ctx context.Context, token string) error
+
+	// PingFunc mocks the Ping method.
+	PingFunc func(n int)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Login holds details about calls to the Login method.
+		Login []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User string
+			// Secret is the secret argument value.
+			Secret string
+		}
+		// Logout holds details about calls to the Logout method.
+		Logout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// Ping holds details about calls to the Ping method.
+		Ping []struct {
+			// N is the n argument value.
+			N int
+		}
+	}
+	lockLogin  sync.RWMutex
+	lockLogout sync.RWMutex
+	lockPing   sync.RWMutex
+}
+
+// NewMoqAuthenticatorAsserted creates a new MoqAuthenticatorAsserted, and registers a cleanup
+// function with t that calls AssertRequiredCalled.
+func NewMoqAuthenticatorAsserted(t testing.TB) *MoqAuthenticatorAsserted {
+	mock := &MoqAuthenticatorAsserted{}
+	t.Cleanup(func() { mock.AssertRequiredCalled(t) })
+	return mock
+}
+
+// Login calls LoginFunc.
+func (mock *MoqAuthenticatorAsserted) Login(ctx context.Context, user string, secret string) (string, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}{
+		Ctx:    ctx,
+		User:   user,
+		Secret: secret,
+	}
+	mock.lockLogin.Lock()
+	mock.calls.Login = append(mock.calls.Login, callInfo)
+	mock.lockLogin.Unlock()
+	if mock.LoginFunc == nil {
+		var (
+			token string
+			err   error
+		)
+		return token, err
+	}
+	return mock.LoginFunc(ctx, user, secret)
+}
+
+// LoginCalls gets all the calls that were made to Login.
+// Check the length with:
+//
+//	len(mockedAuthenticator.LoginCalls())
+func (mock *MoqAuthenticatorAsserted) LoginCalls() []struct {
+	Ctx    context.Context
+	User   string
+	Secret string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}
+	mock.lockLogin.RLock()
+	calls = mock.calls.Login
+	mock.lockLogin.RUnlock()
+	return calls
+}
+
+// ResetLoginCalls reset all the calls that were made to Login.
+func (mock *MoqAuthenticatorAsserted) ResetLoginCalls() {
+	mock.lockLogin.Lock()
+	mock.calls.Login = nil
+	mock.lockLogin.Unlock()
+}
+
+// AssertLoginCalledTimes asserts that Login has been called n times.
+func (mock *MoqAuthenticatorAsserted) AssertLoginCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.LoginCalls()); calls != n {
+		t.Errorf("MoqAuthenticatorAsserted.Login: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertLoginCalledWith asserts that Login has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqAuthenticatorAsserted) AssertLoginCalledWith(t testing.TB, ctx context.Context, user string, secret string) bool {
+	t.Helper()
+	want := struct {
+		Ctx    context.Context
+		User   string
+		Secret string
+	}{
+		Ctx:    ctx,
+		User:   user,
+		Secret: secret,
+	}
+	calls := mock.LoginCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqAuthenticatorAsserted.Login: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
+// Logout calls LogoutFunc.
+func (mock *MoqAuthenticatorAsserted) Logout(ctx context.Context, token string) error {
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockLogout.Lock()
+	mock.calls.Logout = append(mock.calls.Logout, callInfo)
+	mock.lockLogout.Unlock()
+	if mock.LogoutFunc == nil {
+		var (
+			err error
+		)
+		return err
+	}
+	return mock.LogoutFunc(ctx, token)
+}
+
+// LogoutCalls gets all the calls that were made to Logout.
+// Check the length with:
+//
+//	len(mockedAuthenticator.LogoutCalls())
+func (mock *MoqAuthenticatorAsserted) LogoutCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockLogout.RLock()
+	calls = mock.calls.Logout
+	mock.lockLogout.RUnlock()
+	return calls
+}
+
+// ResetLogoutCalls reset all the calls that were made to Logout.
+func (mock *MoqAuthenticatorAsserted) ResetLogoutCalls() {
+	mock.lockLogout.Lock()
+	mock.calls.Logout = nil
+	mock.lockLogout.Unlock()
+}
+
+// AssertLogoutCalledTimes asserts that Logout has been called n times.
+func (mock *MoqAuthenticatorAsserted) AssertLogoutCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.LogoutCalls()); calls != n {
+		t.Errorf("MoqAuthenticatorAsserted.Logout: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertLogoutCalledWith asserts that Logout has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqAuthenticatorAsserted) AssertLogoutCalledWith(t testing.TB, ctx context.Context, token string) bool {
+	t.Helper()
+	want := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	calls := mock.LogoutCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqAuthenticatorAsserted.Logout: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
+// Ping calls PingFunc.
+func (mock *MoqAuthenticatorAsserted) Ping(n int) {
+	callInfo := struct {
+		N int
+	}{
+		N: n,
+	}
+	mock.lockPing.Lock()
+	mock.calls.Ping = append(mock.calls.Ping, callInfo)
+	mock.lockPing.Unlock()
+	if mock.PingFunc == nil {
+		return
+	}
+	mock.PingFunc(n)
+}
+
+// PingCalls gets all the calls that were made to Ping.
+// Check the length with:
+//
+//	len(mockedAuthenticator.PingCalls())
+func (mock *MoqAuthenticatorAsserted) PingCalls() []struct {
+	N int
+} {
+	var calls []struct {
+		N int
+	}
+	mock.lockPing.RLock()
+	calls = mock.calls.Ping
+	mock.lockPing.RUnlock()
+	return calls
+}
+
+// ResetPingCalls reset all the calls that were made to Ping.
+func (mock *MoqAuthenticatorAsserted) ResetPingCalls() {
+	mock.lockPing.Lock()
+	mock.calls.Ping = nil
+	mock.lockPing.Unlock()
+}
+
+// AssertPingCalledTimes asserts that Ping has been called n times.
+func (mock *MoqAuthenticatorAsserted) AssertPingCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.PingCalls()); calls != n {
+		t.Errorf("MoqAuthenticatorAsserted.Ping: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertPingCalledWith asserts that Ping has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqAuthenticatorAsserted) AssertPingCalledWith(t testing.TB, n int) bool {
+	t.Helper()
+	want := struct {
+		N int
+	}{
+		N: n,
+	}
+	calls := mock.PingCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqAuthenticatorAsserted.Ping: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqAuthenticatorAsserted) ResetCalls() {
+	mock.lockLogin.Lock()
+	mock.calls.Login = nil
+	mock.lockLogin.Unlock()
+
+	mock.lockLogout.Lock()
+	mock.calls.Logout = nil
+	mock.lockLogout.Unlock()
+
+	mock.lockPing.Lock()
+	mock.calls.Ping = nil
+	mock.lockPing.Unlock()
+}
+
+// AssertAllCalled asserts that every method with a Func set, or listed in
+// the required-methods of the mock configuration, has been called.
+func (mock *MoqAuthenticatorAsserted) AssertAllCalled(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	if mock.LoginFunc != nil && len(mock.LoginCalls()) == 0 {
+		t.Errorf("MoqAuthenticatorAsserted.Login: expected at least one call, got none")
+		ok = false
+	}
+	if mock.LogoutFunc != nil && len(mock.LogoutCalls()) == 0 {
+		t.Errorf("MoqAuthenticatorAsserted.Logout: expected at least one call, got none")
+		ok = false
+	}
+	if len(mock.PingCalls()) == 0 {
+		t.Errorf("MoqAuthenticatorAsserted.Ping: expected at least one call, got none")
+		ok = false
+	}
+	return ok
+}
+
+// AssertRequiredCalled asserts that every method listed in the required-methods
+// of the mock configuration has been called.
+func (mock *MoqAuthenticatorAsserted) AssertRequiredCalled(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	if len(mock.PingCalls()) == 0 {
+		t.Errorf("MoqAuthenticatorAsserted.Ping: expected at least one call, got none")
+		ok = false
+	}
+	return ok
+}
+
 // Ensure that MoqConsulLock does implement ConsulLock.
 // If this is not the case, regenerate this file with mockery.
 var _ ConsulLock = &MoqConsulLock{}
@@ -3043,12 +3858,255 @@ func (mock *StubMatyerRequester) ResetGetCalls() {
 }
 
 // ResetCalls reset all the calls that were made to all mocked methods.
-func (mock *StubMatyerRequester) ResetCalls() {
+func (mock *StubMatyerRequester) ResetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// Ensure that MoqRequesterScripted does implement Requester.
+// If this is not the case, regenerate this file with mockery.
+var _ Requester = &MoqRequesterScripted{}
+
+// MoqRequesterScripted is a mock implementation of Requester.
+//
+//	func TestSomethingThatUsesRequester(t *testing.T) {
+//
+//		// make and configure a mocked Requester
+//		mockedRequester := &MoqRequesterScripted{
+//			GetFunc: func(path string) (string, error) {
+//				panic("mock out the Get method")
+//			},
+//		}
+//
+//		// use mockedRequester in code that requires Requester
+//		// and then make assertions.
+//
+//	}
+type MoqRequesterScripted struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(path string) (string, error)
+
+	// GetFuncs mocks the successive calls to the Get method: the
+	// first call is served by GetFuncs[0], the second by GetFuncs[1],
+	// and so on. Calls without an entry, or with a nil one, fall back to
+	// GetFunc.
+	GetFuncs []func(path string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Path is the path argument value.
+			Path string
+		}
+	}
+	lockGet sync.RWMutex
+}
+
+// Get calls the entry of GetFuncs for this call, or GetFunc.
+func (mock *MoqRequesterScripted) Get(path string) (string, error) {
+	callInfo := struct {
+		Path string
+	}{
+		Path: path,
+	}
+	mock.lockGet.Lock()
+	callIndex := len(mock.calls.Get)
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	fn := mock.GetFunc
+	if callIndex < len(mock.GetFuncs) && mock.GetFuncs[callIndex] != nil {
+		fn = mock.GetFuncs[callIndex]
+	}
+	if fn == nil {
+		panic(fmt.Sprintf("MoqRequesterScripted.GetFunc: method is nil and GetFuncs has %d entries but Requester.Get was just called %d times", len(mock.GetFuncs), callIndex+1))
+	}
+	return fn(path)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedRequester.GetCalls())
+func (mock *MoqRequesterScripted) GetCalls() []struct {
+	Path string
+} {
+	var calls []struct {
+		Path string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ResetGetCalls reset all the calls that were made to Get.
+func (mock *MoqRequesterScripted) ResetGetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqRequesterScripted) ResetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// Ensure that MoqRequesterAsserted does implement Requester.
+// If this is not the case, regenerate this file with mockery.
+var _ Requester = &MoqRequesterAsserted{}
+
+// MoqRequesterAsserted is a mock implementation of Requester.
+//
+//	func TestSomethingThatUsesRequester(t *testing.T) {
+//
+//		// make and configure a mocked Requester
+//		mockedRequester := &MoqRequesterAsserted{
+//			GetFunc: func(path string) (string, error) {
+//				panic("mock out the Get method")
+//			},
+//		}
+//
+//		// use mockedRequester in code that requires Requester
+//		// and then make assertions.
+//
+//	}
+type MoqRequesterAsserted struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(path string) (string, error)
+
+	// GetFuncs mocks the successive calls to the Get method: the
+	// first call is served by GetFuncs[0], the second by GetFuncs[1],
+	// and so on. Calls without an entry, or with a nil one, fall back to
+	// GetFunc.
+	GetFuncs []func(path string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Path is the path argument value.
+			Path string
+		}
+	}
+	lockGet sync.RWMutex
+}
+
+// NewMoqRequesterAsserted creates a new MoqRequesterAsserted, and registers a cleanup
+// function with t that calls AssertRequiredCalled.
+func NewMoqRequesterAsserted(t testing.TB) *MoqRequesterAsserted {
+	mock := &MoqRequesterAsserted{}
+	t.Cleanup(func() { mock.AssertRequiredCalled(t) })
+	return mock
+}
+
+// Get calls the entry of GetFuncs for this call, or GetFunc.
+func (mock *MoqRequesterAsserted) Get(path string) (string, error) {
+	callInfo := struct {
+		Path string
+	}{
+		Path: path,
+	}
+	mock.lockGet.Lock()
+	callIndex := len(mock.calls.Get)
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	fn := mock.GetFunc
+	if callIndex < len(mock.GetFuncs) && mock.GetFuncs[callIndex] != nil {
+		fn = mock.GetFuncs[callIndex]
+	}
+	if fn == nil {
+		panic(fmt.Sprintf("MoqRequesterAsserted.GetFunc: method is nil and GetFuncs has %d entries but Requester.Get was just called %d times", len(mock.GetFuncs), callIndex+1))
+	}
+	return fn(path)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedRequester.GetCalls())
+func (mock *MoqRequesterAsserted) GetCalls() []struct {
+	Path string
+} {
+	var calls []struct {
+		Path string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ResetGetCalls reset all the calls that were made to Get.
+func (mock *MoqRequesterAsserted) ResetGetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// AssertGetCalledTimes asserts that Get has been called n times.
+func (mock *MoqRequesterAsserted) AssertGetCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.GetCalls()); calls != n {
+		t.Errorf("MoqRequesterAsserted.Get: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertGetCalledWith asserts that Get has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqRequesterAsserted) AssertGetCalledWith(t testing.TB, path string) bool {
+	t.Helper()
+	want := struct {
+		Path string
+	}{
+		Path: path,
+	}
+	calls := mock.GetCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqRequesterAsserted.Get: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqRequesterAsserted) ResetCalls() {
 	mock.lockGet.Lock()
 	mock.calls.Get = nil
 	mock.lockGet.Unlock()
 }
 
+// AssertAllCalled asserts that every method with a Func set, or listed in
+// the required-methods of the mock configuration, has been called.
+// It also asserts that every entry of the Funcs fields has been used.
+func (mock *MoqRequesterAsserted) AssertAllCalled(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	if mock.GetFunc != nil && len(mock.GetCalls()) == 0 {
+		t.Errorf("MoqRequesterAsserted.Get: expected at least one call, got none")
+		ok = false
+	} else if len(mock.GetCalls()) < len(mock.GetFuncs) {
+		t.Errorf("MoqRequesterAsserted.Get: expected %d calls to use GetFuncs, got %d", len(mock.GetFuncs), len(mock.GetCalls()))
+		ok = false
+	}
+	return ok
+}
+
+// AssertRequiredCalled asserts that every method listed in the required-methods
+// of the mock configuration has been called.
+func (mock *MoqRequesterAsserted) AssertRequiredCalled(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	return ok
+}
+
 // Ensure that MoqRequester2 does implement Requester2.
 // If this is not the case, regenerate this file with mockery.
 var _ Requester2 = &MoqRequester2{}
@@ -4130,132 +5188,401 @@ func (mock *MoqRequesterSlice) Get(path string) ([]string, error) {
 	}{
 		Path: path,
 	}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	if mock.GetFunc == nil {
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			strings []string
+			err     error
+		)
+		return strings, err
+	}
+	return mock.GetFunc(path)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedRequesterSlice.GetCalls())
+func (mock *MoqRequesterSlice) GetCalls() []struct {
+	Path string
+} {
+	var calls []struct {
+		Path string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ResetGetCalls reset all the calls that were made to Get.
+func (mock *MoqRequesterSlice) ResetGetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqRequesterSlice) ResetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// Ensure that MoqrequesterUnexported does implement requesterUnexported.
+// If this is not the case, regenerate this file with mockery.
+var _ requesterUnexported = &MoqrequesterUnexported{}
+
+// MoqrequesterUnexported is a mock implementation of requesterUnexported.
+//
+//	func TestSomethingThatUsesrequesterUnexported(t *testing.T) {
+//
+//		// make and configure a mocked requesterUnexported
+//		mockedrequesterUnexported := &MoqrequesterUnexported{
+//			GetFunc: func()  {
+//				panic("mock out the Get method")
+//			},
+//		}
+//
+//		// use mockedrequesterUnexported in code that requires requesterUnexported
+//		// and then make assertions.
+//
+//	}
+type MoqrequesterUnexported struct {
+	// GetFunc mocks the Get method.
+	GetFunc func()
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+		}
+	}
+	lockGet sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *MoqrequesterUnexported) Get() {
+	callInfo := struct {
+	}{}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		return
+	}
+	mock.GetFunc()
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedrequesterUnexported.GetCalls())
+func (mock *MoqrequesterUnexported) GetCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ResetGetCalls reset all the calls that were made to Get.
+func (mock *MoqrequesterUnexported) ResetGetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// ResetCalls reset all the calls that were made to all mocked methods.
+func (mock *MoqrequesterUnexported) ResetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// Ensure that MoqRequesterVariadic does implement RequesterVariadic.
+// If this is not the case, regenerate this file with mockery.
+var _ RequesterVariadic = &MoqRequesterVariadic{}
+
+// MoqRequesterVariadic is a mock implementation of RequesterVariadic.
+//
+//	func TestSomethingThatUsesRequesterVariadic(t *testing.T) {
+//
+//		// make and configure a mocked RequesterVariadic
+//		mockedRequesterVariadic := &MoqRequesterVariadic{
+//			GetFunc: func(values ...string) bool {
+//				panic("mock out the Get method")
+//			},
+//			MultiWriteToFileFunc: func(filename string, w ...io.Writer) string {
+//				panic("mock out the MultiWriteToFile method")
+//			},
+//			OneInterfaceFunc: func(a ...interface{}) bool {
+//				panic("mock out the OneInterface method")
+//			},
+//			SprintfFunc: func(format string, a ...interface{}) string {
+//				panic("mock out the Sprintf method")
+//			},
+//		}
+//
+//		// use mockedRequesterVariadic in code that requires RequesterVariadic
+//		// and then make assertions.
+//
+//	}
+type MoqRequesterVariadic struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(values ...string) bool
+
+	// MultiWriteToFileFunc mocks the MultiWriteToFile method.
+	MultiWriteToFileFunc func(filename string, w ...io.Writer) string
+
+	// OneInterfaceFunc mocks the OneInterface method.
+	OneInterfaceFunc func(a ...interface{}) bool
+
+	// SprintfFunc mocks the Sprintf method.
+	SprintfFunc func(format string, a ...interface{}) string
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Values is the values argument value.
+			Values []string
+		}
+		// MultiWriteToFile holds details about calls to the MultiWriteToFile method.
+		MultiWriteToFile []struct {
+			// Filename is the filename argument value.
+			Filename string
+			// W is the w argument value.
+			W []io.Writer
+		}
+		// OneInterface holds details about calls to the OneInterface method.
+		OneInterface []struct {
+			// A is the a argument value.
+			A []interface{}
+		}
+		// Sprintf holds details about calls to the Sprintf method.
+		Sprintf []struct {
+			// Format is the format argument value.
+			Format string
+			// A is the a argument value.
+			A []interface{}
+		}
+	}
+	lockGet              sync.RWMutex
+	lockMultiWriteToFile sync.RWMutex
+	lockOneInterface     sync.RWMutex
+	lockSprintf          sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *MoqRequesterVariadic) Get(values ...string) bool {
+	callInfo := struct {
+		Values []string
+	}{
+		Values: values,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			b bool
+		)
+		return b
+	}
+	return mock.GetFunc(values...)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedRequesterVariadic.GetCalls())
+func (mock *MoqRequesterVariadic) GetCalls() []struct {
+	Values []string
+} {
+	var calls []struct {
+		Values []string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// ResetGetCalls reset all the calls that were made to Get.
+func (mock *MoqRequesterVariadic) ResetGetCalls() {
+	mock.lockGet.Lock()
+	mock.calls.Get = nil
+	mock.lockGet.Unlock()
+}
+
+// MultiWriteToFile calls MultiWriteToFileFunc.
+func (mock *MoqRequesterVariadic) MultiWriteToFile(filename string, w ...io.Writer) string {
+	callInfo := struct {
+		Filename string
+		W        []io.Writer
+	}{
+		Filename: filename,
+		W:        w,
+	}
+	mock.lockMultiWriteToFile.Lock()
+	mock.calls.MultiWriteToFile = append(mock.calls.MultiWriteToFile, callInfo)
+	mock.lockMultiWriteToFile.Unlock()
+	if mock.MultiWriteToFileFunc == nil {
 		var (
-			strings []string
-			err     error
+			s string
 		)
-		return strings, err
+		return s
 	}
-	return mock.GetFunc(path)
+	return mock.MultiWriteToFileFunc(filename, w...)
 }
 
-// GetCalls gets all the calls that were made to Get.
+// MultiWriteToFileCalls gets all the calls that were made to MultiWriteToFile.
 // Check the length with:
 //
-//	len(mockedRequesterSlice.GetCalls())
-func (mock *MoqRequesterSlice) GetCalls() []struct {
-	Path string
+//	len(mockedRequesterVariadic.MultiWriteToFileCalls())
+func (mock *MoqRequesterVariadic) MultiWriteToFileCalls() []struct {
+	Filename string
+	W        []io.Writer
 } {
 	var calls []struct {
-		Path string
+		Filename string
+		W        []io.Writer
 	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+	mock.lockMultiWriteToFile.RLock()
+	calls = mock.calls.MultiWriteToFile
+	mock.lockMultiWriteToFile.RUnlock()
 	return calls
 }
 
-// ResetGetCalls reset all the calls that were made to Get.
-func (mock *MoqRequesterSlice) ResetGetCalls() {
-	mock.lockGet.Lock()
-	mock.calls.Get = nil
-	mock.lockGet.Unlock()
+// ResetMultiWriteToFileCalls reset all the calls that were made to MultiWriteToFile.
+func (mock *MoqRequesterVariadic) ResetMultiWriteToFileCalls() {
+	mock.lockMultiWriteToFile.Lock()
+	mock.calls.MultiWriteToFile = nil
+	mock.lockMultiWriteToFile.Unlock()
 }
 
-// ResetCalls reset all the calls that were made to all mocked methods.
-func (mock *MoqRequesterSlice) ResetCalls() {
-	mock.lockGet.Lock()
-	mock.calls.Get = nil
-	mock.lockGet.Unlock()
+// OneInterface calls OneInterfaceFunc.
+func (mock *MoqRequesterVariadic) OneInterface(a ...interface{}) bool {
+	callInfo := struct {
+		A []interface{}
+	}{
+		A: a,
+	}
+	mock.lockOneInterface.Lock()
+	mock.calls.OneInterface = append(mock.calls.OneInterface, callInfo)
+	mock.lockOneInterface.Unlock()
+	if mock.OneInterfaceFunc == nil {
+		var (
+			b bool
+		)
+		return b
+	}
+	return mock.OneInterfaceFunc(a...)
 }
 
-// Ensure that MoqrequesterUnexported does implement requesterUnexported.
-// If this is not the case, regenerate this file with mockery.
-var _ requesterUnexported = &MoqrequesterUnexported{}
-
-// MoqrequesterUnexported is a mock implementation of requesterUnexported.
-//
-//	func TestSomethingThatUsesrequesterUnexported(t *testing.T) {
-//
-//		// make and configure a mocked requesterUnexported
-//		mockedrequesterUnexported := &MoqrequesterUnexported{
-//			GetFunc: func()  {
-//				panic("mock out the Get method")
-//			},
-//		}
-//
-//		// use mockedrequesterUnexported in code that requires requesterUnexported
-//		// and then make assertions.
+// OneInterfaceCalls gets all the calls that were made to OneInterface.
+// Check the length with:
 //
-//	}
-type MoqrequesterUnexported struct {
-	// GetFunc mocks the Get method.
-	GetFunc func()
-
-	// calls tracks calls to the methods.
-	calls struct {
-		// Get holds details about calls to the Get method.
-		Get []struct {
-		}
+//	len(mockedRequesterVariadic.OneInterfaceCalls())
+func (mock *MoqRequesterVariadic) OneInterfaceCalls() []struct {
+	A []interface{}
+} {
+	var calls []struct {
+		A []interface{}
 	}
-	lockGet sync.RWMutex
+	mock.lockOneInterface.RLock()
+	calls = mock.calls.OneInterface
+	mock.lockOneInterface.RUnlock()
+	return calls
 }
 
-// Get calls GetFunc.
-func (mock *MoqrequesterUnexported) Get() {
+// ResetOneInterfaceCalls reset all the calls that were made to OneInterface.
+func (mock *MoqRequesterVariadic) ResetOneInterfaceCalls() {
+	mock.lockOneInterface.Lock()
+	mock.calls.OneInterface = nil
+	mock.lockOneInterface.Unlock()
+}
+
+// Sprintf calls SprintfFunc.
+func (mock *MoqRequesterVariadic) Sprintf(format string, a ...interface{}) string {
 	callInfo := struct {
-	}{}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	if mock.GetFunc == nil {
-		return
+		Format string
+		A      []interface{}
+	}{
+		Format: format,
+		A:      a,
 	}
-	mock.GetFunc()
+	mock.lockSprintf.Lock()
+	mock.calls.Sprintf = append(mock.calls.Sprintf, callInfo)
+	mock.lockSprintf.Unlock()
+	if mock.SprintfFunc == nil {
+		var (
+			s string
+		)
+		return s
+	}
+	return mock.SprintfFunc(format, a...)
 }
 
-// GetCalls gets all the calls that were made to Get.
+// SprintfCalls gets all the calls that were made to Sprintf.
 // Check the length with:
 //
-//	len(mockedrequesterUnexported.GetCalls())
-func (mock *MoqrequesterUnexported) GetCalls() []struct {
+//	len(mockedRequesterVariadic.SprintfCalls())
+func (mock *MoqRequesterVariadic) SprintfCalls() []struct {
+	Format string
+	A      []interface{}
 } {
 	var calls []struct {
+		Format string
+		A      []interface{}
 	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+	mock.lockSprintf.RLock()
+	calls = mock.calls.Sprintf
+	mock.lockSprintf.RUnlock()
 	return calls
 }
 
-// ResetGetCalls reset all the calls that were made to Get.
-func (mock *MoqrequesterUnexported) ResetGetCalls() {
-	mock.lockGet.Lock()
-	mock.calls.Get = nil
-	mock.lockGet.Unlock()
+// ResetSprintfCalls reset all the calls that were made to Sprintf.
+func (mock *MoqRequesterVariadic) ResetSprintfCalls() {
+	mock.lockSprintf.Lock()
+	mock.calls.Sprintf = nil
+	mock.lockSprintf.Unlock()
 }
 
 // ResetCalls reset all the calls that were made to all mocked methods.
-func (mock *MoqrequesterUnexported) ResetCalls() {
+func (mock *MoqRequesterVariadic) ResetCalls() {
 	mock.lockGet.Lock()
 	mock.calls.Get = nil
 	mock.lockGet.Unlock()
+
+	mock.lockMultiWriteToFile.Lock()
+	mock.calls.MultiWriteToFile = nil
+	mock.lockMultiWriteToFile.Unlock()
+
+	mock.lockOneInterface.Lock()
+	mock.calls.OneInterface = nil
+	mock.lockOneInterface.Unlock()
+
+	mock.lockSprintf.Lock()
+	mock.calls.Sprintf = nil
+	mock.lockSprintf.Unlock()
 }
 
-// Ensure that MoqRequesterVariadic does implement RequesterVariadic.
+// Ensure that MoqRequesterVariadicAsserted does implement RequesterVariadic.
 // If this is not the case, regenerate this file with mockery.
-var _ RequesterVariadic = &MoqRequesterVariadic{}
+var _ RequesterVariadic = &MoqRequesterVariadicAsserted{}
 
-// MoqRequesterVariadic is a mock implementation of RequesterVariadic.
+// MoqRequesterVariadicAsserted is a mock implementation of RequesterVariadic.
 //
 //	func TestSomethingThatUsesRequesterVariadic(t *testing.T) {
 //
 //		// make and configure a mocked RequesterVariadic
-//		mockedRequesterVariadic := &MoqRequesterVariadic{
+//		mockedRequesterVariadic := &MoqRequesterVariadicAsserted{
 //			GetFunc: func(values ...string) bool {
 //				panic("mock out the Get method")
 //			},
@@ -4274,7 +5601,7 @@ var _ RequesterVariadic = &MoqRequesterVariadic{}
 //		// and then make assertions.
 //
 //	}
-type MoqRequesterVariadic struct {
+type MoqRequesterVariadicAsserted struct {
 	// GetFunc mocks the Get method.
 	GetFunc func(values ...string) bool
 
@@ -4320,8 +5647,16 @@ type MoqRequesterVariadic struct {
 	lockSprintf          sync.RWMutex
 }
 
+// NewMoqRequesterVariadicAsserted creates a new MoqRequesterVariadicAsserted, and registers a cleanup
+// function with t that calls AssertRequiredCalled.
+func NewMoqRequesterVariadicAsserted(t testing.TB) *MoqRequesterVariadicAsserted {
+	mock := &MoqRequesterVariadicAsserted{}
+	t.Cleanup(func() { mock.AssertRequiredCalled(t) })
+	return mock
+}
+
 // Get calls GetFunc.
-func (mock *MoqRequesterVariadic) Get(values ...string) bool {
+func (mock *MoqRequesterVariadicAsserted) Get(values ...string) bool {
 	callInfo := struct {
 		Values []string
 	}{
@@ -4343,7 +5678,7 @@ func (mock *MoqRequesterVariadic) Get(values ...string) bool {
 // Check the length with:
 //
 //	len(mockedRequesterVariadic.GetCalls())
-func (mock *MoqRequesterVariadic) GetCalls() []struct {
+func (mock *MoqRequesterVariadicAsserted) GetCalls() []struct {
 	Values []string
 } {
 	var calls []struct {
@@ -4356,14 +5691,43 @@ func (mock *MoqRequesterVariadic) GetCalls() []struct {
 }
 
 // ResetGetCalls reset all the calls that were made to Get.
-func (mock *MoqRequesterVariadic) ResetGetCalls() {
+func (mock *MoqRequesterVariadicAsserted) ResetGetCalls() {
 	mock.lockGet.Lock()
 	mock.calls.Get = nil
 	mock.lockGet.Unlock()
 }
 
+// AssertGetCalledTimes asserts that Get has been called n times.
+func (mock *MoqRequesterVariadicAsserted) AssertGetCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.GetCalls()); calls != n {
+		t.Errorf("MoqRequesterVariadicAsserted.Get: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertGetCalledWith asserts that Get has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqRequesterVariadicAsserted) AssertGetCalledWith(t testing.TB, values ...string) bool {
+	t.Helper()
+	want := struct {
+		Values []string
+	}{
+		Values: values,
+	}
+	calls := mock.GetCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqRequesterVariadicAsserted.Get: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
 // MultiWriteToFile calls MultiWriteToFileFunc.
-func (mock *MoqRequesterVariadic) MultiWriteToFile(filename string, w ...io.Writer) string {
+func (mock *MoqRequesterVariadicAsserted) MultiWriteToFile(filename string, w ...io.Writer) string {
 	callInfo := struct {
 		Filename string
 		W        []io.Writer
@@ -4387,7 +5751,7 @@ func (mock *MoqRequesterVariadic) MultiWriteToFile(filename string, w ...io.Writ
 // Check the length with:
 //
 //	len(mockedRequesterVariadic.MultiWriteToFileCalls())
-func (mock *MoqRequesterVariadic) MultiWriteToFileCalls() []struct {
+func (mock *MoqRequesterVariadicAsserted) MultiWriteToFileCalls() []struct {
 	Filename string
 	W        []io.Writer
 } {
@@ -4402,14 +5766,45 @@ func (mock *MoqRequesterVariadic) MultiWriteToFileCalls() []struct {
 }
 
 // ResetMultiWriteToFileCalls reset all the calls that were made to MultiWriteToFile.
-func (mock *MoqRequesterVariadic) ResetMultiWriteToFileCalls() {
+func (mock *MoqRequesterVariadicAsserted) ResetMultiWriteToFileCalls() {
 	mock.lockMultiWriteToFile.Lock()
 	mock.calls.MultiWriteToFile = nil
 	mock.lockMultiWriteToFile.Unlock()
 }
 
+// AssertMultiWriteToFileCalledTimes asserts that MultiWriteToFile has been called n times.
+func (mock *MoqRequesterVariadicAsserted) AssertMultiWriteToFileCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.MultiWriteToFileCalls()); calls != n {
+		t.Errorf("MoqRequesterVariadicAsserted.MultiWriteToFile: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertMultiWriteToFileCalledWith asserts that MultiWriteToFile has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqRequesterVariadicAsserted) AssertMultiWriteToFileCalledWith(t testing.TB, filename string, w ...io.Writer) bool {
+	t.Helper()
+	want := struct {
+		Filename string
+		W        []io.Writer
+	}{
+		Filename: filename,
+		W:        w,
+	}
+	calls := mock.MultiWriteToFileCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqRequesterVariadicAsserted.MultiWriteToFile: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
 // OneInterface calls OneInterfaceFunc.
-func (mock *MoqRequesterVariadic) OneInterface(a ...interface{}) bool {
+func (mock *MoqRequesterVariadicAsserted) OneInterface(a ...interface{}) bool {
 	callInfo := struct {
 		A []interface{}
 	}{
@@ -4431,7 +5826,7 @@ func (mock *MoqRequesterVariadic) OneInterface(a ...interface{}) bool {
 // Check the length with:
 //
 //	len(mockedRequesterVariadic.OneInterfaceCalls())
-func (mock *MoqRequesterVariadic) OneInterfaceCalls() []struct {
+func (mock *MoqRequesterVariadicAsserted) OneInterfaceCalls() []struct {
 	A []interface{}
 } {
 	var calls []struct {
@@ -4444,14 +5839,43 @@ func (mock *MoqRequesterVariadic) OneInterfaceCalls() []struct {
 }
 
 // ResetOneInterfaceCalls reset all the calls that were made to OneInterface.
-func (mock *MoqRequesterVariadic) ResetOneInterfaceCalls() {
+func (mock *MoqRequesterVariadicAsserted) ResetOneInterfaceCalls() {
 	mock.lockOneInterface.Lock()
 	mock.calls.OneInterface = nil
 	mock.lockOneInterface.Unlock()
 }
 
+// AssertOneInterfaceCalledTimes asserts that OneInterface has been called n times.
+func (mock *MoqRequesterVariadicAsserted) AssertOneInterfaceCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.OneInterfaceCalls()); calls != n {
+		t.Errorf("MoqRequesterVariadicAsserted.OneInterface: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertOneInterfaceCalledWith asserts that OneInterface has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqRequesterVariadicAsserted) AssertOneInterfaceCalledWith(t testing.TB, a ...interface{}) bool {
+	t.Helper()
+	want := struct {
+		A []interface{}
+	}{
+		A: a,
+	}
+	calls := mock.OneInterfaceCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqRequesterVariadicAsserted.OneInterface: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
 // Sprintf calls SprintfFunc.
-func (mock *MoqRequesterVariadic) Sprintf(format string, a ...interface{}) string {
+func (mock *MoqRequesterVariadicAsserted) Sprintf(format string, a ...interface{}) string {
 	callInfo := struct {
 		Format string
 		A      []interface{}
@@ -4475,7 +5899,7 @@ func (mock *MoqRequesterVariadic) Sprintf(format string, a ...interface{}) strin
 // Check the length with:
 //
 //	len(mockedRequesterVariadic.SprintfCalls())
-func (mock *MoqRequesterVariadic) SprintfCalls() []struct {
+func (mock *MoqRequesterVariadicAsserted) SprintfCalls() []struct {
 	Format string
 	A      []interface{}
 } {
@@ -4490,14 +5914,45 @@ func (mock *MoqRequesterVariadic) SprintfCalls() []struct {
 }
 
 // ResetSprintfCalls reset all the calls that were made to Sprintf.
-func (mock *MoqRequesterVariadic) ResetSprintfCalls() {
+func (mock *MoqRequesterVariadicAsserted) ResetSprintfCalls() {
 	mock.lockSprintf.Lock()
 	mock.calls.Sprintf = nil
 	mock.lockSprintf.Unlock()
 }
 
+// AssertSprintfCalledTimes asserts that Sprintf has been called n times.
+func (mock *MoqRequesterVariadicAsserted) AssertSprintfCalledTimes(t testing.TB, n int) bool {
+	t.Helper()
+	if calls := len(mock.SprintfCalls()); calls != n {
+		t.Errorf("MoqRequesterVariadicAsserted.Sprintf: expected %d calls, got %d", n, calls)
+		return false
+	}
+	return true
+}
+
+// AssertSprintfCalledWith asserts that Sprintf has been called with arguments
+// deeply equal to the given ones.
+func (mock *MoqRequesterVariadicAsserted) AssertSprintfCalledWith(t testing.TB, format string, a ...interface{}) bool {
+	t.Helper()
+	want := struct {
+		Format string
+		A      []interface{}
+	}{
+		Format: format,
+		A:      a,
+	}
+	calls := mock.SprintfCalls()
+	for _, call := range calls {
+		if reflect.DeepEqual(call, want) {
+			return true
+		}
+	}
+	t.Errorf("MoqRequesterVariadicAsserted.Sprintf: expected a call with %+v, got %+v", want, calls)
+	return false
+}
+
 // ResetCalls reset all the calls that were made to all mocked methods.
-func (mock *MoqRequesterVariadic) ResetCalls() {
+func (mock *MoqRequesterVariadicAsserted) ResetCalls() {
 	mock.lockGet.Lock()
 	mock.calls.Get = nil
 	mock.lockGet.Unlock()
@@ -4515,6 +5970,38 @@ func (mock *MoqRequesterVariadic) ResetCalls() {
 	mock.lockSprintf.Unlock()
 }
 
+// AssertAllCalled asserts that every method with a Func set, or listed in
+// the required-methods of the mock configuration, has been called.
+func (mock *MoqRequesterVariadicAsserted) AssertAllCalled(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	if mock.GetFunc != nil && len(mock.GetCalls()) == 0 {
+		t.Errorf("MoqRequesterVariadicAsserted.Get: expected at least one call, got none")
+		ok = false
+	}
+	if mock.MultiWriteToFileFunc != nil && len(mock.MultiWriteToFileCalls()) == 0 {
+		t.Errorf("MoqRequesterVariadicAsserted.MultiWriteToFile: expected at least one call, got none")
+		ok = false
+	}
+	if mock.OneInterfaceFunc != nil && len(mock.OneInterfaceCalls()) == 0 {
+		t.Errorf("MoqRequesterVariadicAsserted.OneInterface: expected at least one call, got none")
+		ok = false
+	}
+	if mock.SprintfFunc != nil && len(mock.SprintfCalls()) == 0 {
+		t.Errorf("MoqRequesterVariadicAsserted.Sprintf: expected at least one call, got none")
+		ok = false
+	}
+	return ok
+}
+
+// AssertRequiredCalled asserts that every method listed in the required-methods
+// of the mock configuration has been called.
+func (mock *MoqRequesterVariadicAsserted) AssertRequiredCalled(t testing.TB) bool {
+	t.Helper()
+	ok := true
+	return ok
+}
+
 // Ensure that MoqExample does implement Example.
 // If this is not the case, regenerate this file with mockery.
 var _ Example = &MoqExample{}
@@ -7,6 +7,7 @@ package test
 
 import (
 	"io"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -92,6 +93,46 @@ func (_c *MockReader_Read_Call) RunAndReturn(run func(p []byte) (int, error)) *M
 	return _c
 }
 
+func (_c *MockReader_Read_Call) Once() *MockReader_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReader_Read_Call) Twice() *MockReader_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReader_Read_Call) Times(i int) *MockReader_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReader_Read_Call) Maybe() *MockReader_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReader_Read_Call) After(d time.Duration) *MockReader_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReader_Read_Call) WaitUntil(w <-chan time.Time) *MockReader_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReader_Read_Call) NotBefore(calls ...*mock.Call) *MockReader_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReader_Read_Call) Unset() *MockReader_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockWriter creates a new instance of MockWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriter(t interface {
@@ -173,6 +214,46 @@ func (_c *MockWriter_Write_Call) RunAndReturn(run func(p []byte) (int, error)) *
 	return _c
 }
 
+func (_c *MockWriter_Write_Call) Once() *MockWriter_Write_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) Twice() *MockWriter_Write_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) Times(i int) *MockWriter_Write_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) Maybe() *MockWriter_Write_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) After(d time.Duration) *MockWriter_Write_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) WaitUntil(w <-chan time.Time) *MockWriter_Write_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) NotBefore(calls ...*mock.Call) *MockWriter_Write_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriter_Write_Call) Unset() *MockWriter_Write_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockCloser creates a new instance of MockCloser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCloser(t interface {
@@ -244,6 +325,46 @@ func (_c *MockCloser_Close_Call) RunAndReturn(run func() error) *MockCloser_Clos
 	return _c
 }
 
+func (_c *MockCloser_Close_Call) Once() *MockCloser_Close_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) Twice() *MockCloser_Close_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) Times(i int) *MockCloser_Close_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) Maybe() *MockCloser_Close_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) After(d time.Duration) *MockCloser_Close_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) WaitUntil(w <-chan time.Time) *MockCloser_Close_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) NotBefore(calls ...*mock.Call) *MockCloser_Close_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockCloser_Close_Call) Unset() *MockCloser_Close_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockSeeker creates a new instance of MockSeeker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSeeker(t interface {
@@ -326,6 +447,46 @@ func (_c *MockSeeker_Seek_Call) RunAndReturn(run func(offset int64, whence int)
 	return _c
 }
 
+func (_c *MockSeeker_Seek_Call) Once() *MockSeeker_Seek_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) Twice() *MockSeeker_Seek_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) Times(i int) *MockSeeker_Seek_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) Maybe() *MockSeeker_Seek_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) After(d time.Duration) *MockSeeker_Seek_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) WaitUntil(w <-chan time.Time) *MockSeeker_Seek_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) NotBefore(calls ...*mock.Call) *MockSeeker_Seek_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockSeeker_Seek_Call) Unset() *MockSeeker_Seek_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReadWriter creates a new instance of MockReadWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReadWriter(t interface {
@@ -407,6 +568,46 @@ func (_c *MockReadWriter_Read_Call) RunAndReturn(run func(p []byte) (int, error)
 	return _c
 }
 
+func (_c *MockReadWriter_Read_Call) Once() *MockReadWriter_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) Twice() *MockReadWriter_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) Times(i int) *MockReadWriter_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) Maybe() *MockReadWriter_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) After(d time.Duration) *MockReadWriter_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) WaitUntil(w <-chan time.Time) *MockReadWriter_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) NotBefore(calls ...*mock.Call) *MockReadWriter_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriter_Read_Call) Unset() *MockReadWriter_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Write provides a mock function for the type MockReadWriter
 func (_mock *MockReadWriter) Write(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -461,6 +662,46 @@ func (_c *MockReadWriter_Write_Call) RunAndReturn(run func(p []byte) (int, error
 	return _c
 }
 
+func (_c *MockReadWriter_Write_Call) Once() *MockReadWriter_Write_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) Twice() *MockReadWriter_Write_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) Times(i int) *MockReadWriter_Write_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) Maybe() *MockReadWriter_Write_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) After(d time.Duration) *MockReadWriter_Write_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) WaitUntil(w <-chan time.Time) *MockReadWriter_Write_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) NotBefore(calls ...*mock.Call) *MockReadWriter_Write_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriter_Write_Call) Unset() *MockReadWriter_Write_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReadCloser creates a new instance of MockReadCloser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReadCloser(t interface {
@@ -532,6 +773,46 @@ func (_c *MockReadCloser_Close_Call) RunAndReturn(run func() error) *MockReadClo
 	return _c
 }
 
+func (_c *MockReadCloser_Close_Call) Once() *MockReadCloser_Close_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) Twice() *MockReadCloser_Close_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) Times(i int) *MockReadCloser_Close_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) Maybe() *MockReadCloser_Close_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) After(d time.Duration) *MockReadCloser_Close_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) WaitUntil(w <-chan time.Time) *MockReadCloser_Close_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) NotBefore(calls ...*mock.Call) *MockReadCloser_Close_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadCloser_Close_Call) Unset() *MockReadCloser_Close_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Read provides a mock function for the type MockReadCloser
 func (_mock *MockReadCloser) Read(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -586,6 +867,46 @@ func (_c *MockReadCloser_Read_Call) RunAndReturn(run func(p []byte) (int, error)
 	return _c
 }
 
+func (_c *MockReadCloser_Read_Call) Once() *MockReadCloser_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) Twice() *MockReadCloser_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) Times(i int) *MockReadCloser_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) Maybe() *MockReadCloser_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) After(d time.Duration) *MockReadCloser_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) WaitUntil(w <-chan time.Time) *MockReadCloser_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) NotBefore(calls ...*mock.Call) *MockReadCloser_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadCloser_Read_Call) Unset() *MockReadCloser_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockWriteCloser creates a new instance of MockWriteCloser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriteCloser(t interface {
@@ -657,6 +978,46 @@ func (_c *MockWriteCloser_Close_Call) RunAndReturn(run func() error) *MockWriteC
 	return _c
 }
 
+func (_c *MockWriteCloser_Close_Call) Once() *MockWriteCloser_Close_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) Twice() *MockWriteCloser_Close_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) Times(i int) *MockWriteCloser_Close_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) Maybe() *MockWriteCloser_Close_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) After(d time.Duration) *MockWriteCloser_Close_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) WaitUntil(w <-chan time.Time) *MockWriteCloser_Close_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) NotBefore(calls ...*mock.Call) *MockWriteCloser_Close_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriteCloser_Close_Call) Unset() *MockWriteCloser_Close_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Write provides a mock function for the type MockWriteCloser
 func (_mock *MockWriteCloser) Write(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -711,6 +1072,46 @@ func (_c *MockWriteCloser_Write_Call) RunAndReturn(run func(p []byte) (int, erro
 	return _c
 }
 
+func (_c *MockWriteCloser_Write_Call) Once() *MockWriteCloser_Write_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) Twice() *MockWriteCloser_Write_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) Times(i int) *MockWriteCloser_Write_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) Maybe() *MockWriteCloser_Write_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) After(d time.Duration) *MockWriteCloser_Write_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) WaitUntil(w <-chan time.Time) *MockWriteCloser_Write_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) NotBefore(calls ...*mock.Call) *MockWriteCloser_Write_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriteCloser_Write_Call) Unset() *MockWriteCloser_Write_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReadWriteCloser creates a new instance of MockReadWriteCloser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReadWriteCloser(t interface {
@@ -782,20 +1183,60 @@ func (_c *MockReadWriteCloser_Close_Call) RunAndReturn(run func() error) *MockRe
 	return _c
 }
 
-// Read provides a mock function for the type MockReadWriteCloser
-func (_mock *MockReadWriteCloser) Read(p []byte) (int, error) {
-	ret := _mock.Called(p)
+func (_c *MockReadWriteCloser_Close_Call) Once() *MockReadWriteCloser_Close_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for Read")
-	}
+func (_c *MockReadWriteCloser_Close_Call) Twice() *MockReadWriteCloser_Close_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	var r0 int
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func([]byte) (int, error)); ok {
-		return returnFunc(p)
-	}
-	if returnFunc, ok := ret.Get(0).(func([]byte) int); ok {
+func (_c *MockReadWriteCloser_Close_Call) Times(i int) *MockReadWriteCloser_Close_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Close_Call) Maybe() *MockReadWriteCloser_Close_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Close_Call) After(d time.Duration) *MockReadWriteCloser_Close_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Close_Call) WaitUntil(w <-chan time.Time) *MockReadWriteCloser_Close_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Close_Call) NotBefore(calls ...*mock.Call) *MockReadWriteCloser_Close_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Close_Call) Unset() *MockReadWriteCloser_Close_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Read provides a mock function for the type MockReadWriteCloser
+func (_mock *MockReadWriteCloser) Read(p []byte) (int, error) {
+	ret := _mock.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte) (int, error)); ok {
+		return returnFunc(p)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte) int); ok {
 		r0 = returnFunc(p)
 	} else {
 		r0 = ret.Get(0).(int)
@@ -836,6 +1277,46 @@ func (_c *MockReadWriteCloser_Read_Call) RunAndReturn(run func(p []byte) (int, e
 	return _c
 }
 
+func (_c *MockReadWriteCloser_Read_Call) Once() *MockReadWriteCloser_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) Twice() *MockReadWriteCloser_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) Times(i int) *MockReadWriteCloser_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) Maybe() *MockReadWriteCloser_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) After(d time.Duration) *MockReadWriteCloser_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) WaitUntil(w <-chan time.Time) *MockReadWriteCloser_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) NotBefore(calls ...*mock.Call) *MockReadWriteCloser_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Read_Call) Unset() *MockReadWriteCloser_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Write provides a mock function for the type MockReadWriteCloser
 func (_mock *MockReadWriteCloser) Write(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -890,6 +1371,46 @@ func (_c *MockReadWriteCloser_Write_Call) RunAndReturn(run func(p []byte) (int,
 	return _c
 }
 
+func (_c *MockReadWriteCloser_Write_Call) Once() *MockReadWriteCloser_Write_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) Twice() *MockReadWriteCloser_Write_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) Times(i int) *MockReadWriteCloser_Write_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) Maybe() *MockReadWriteCloser_Write_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) After(d time.Duration) *MockReadWriteCloser_Write_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) WaitUntil(w <-chan time.Time) *MockReadWriteCloser_Write_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) NotBefore(calls ...*mock.Call) *MockReadWriteCloser_Write_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriteCloser_Write_Call) Unset() *MockReadWriteCloser_Write_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReadSeeker creates a new instance of MockReadSeeker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReadSeeker(t interface {
@@ -971,6 +1492,46 @@ func (_c *MockReadSeeker_Read_Call) RunAndReturn(run func(p []byte) (int, error)
 	return _c
 }
 
+func (_c *MockReadSeeker_Read_Call) Once() *MockReadSeeker_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) Twice() *MockReadSeeker_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) Times(i int) *MockReadSeeker_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) Maybe() *MockReadSeeker_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) After(d time.Duration) *MockReadSeeker_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) WaitUntil(w <-chan time.Time) *MockReadSeeker_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) NotBefore(calls ...*mock.Call) *MockReadSeeker_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadSeeker_Read_Call) Unset() *MockReadSeeker_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Seek provides a mock function for the type MockReadSeeker
 func (_mock *MockReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	ret := _mock.Called(offset, whence)
@@ -1026,6 +1587,46 @@ func (_c *MockReadSeeker_Seek_Call) RunAndReturn(run func(offset int64, whence i
 	return _c
 }
 
+func (_c *MockReadSeeker_Seek_Call) Once() *MockReadSeeker_Seek_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) Twice() *MockReadSeeker_Seek_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) Times(i int) *MockReadSeeker_Seek_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) Maybe() *MockReadSeeker_Seek_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) After(d time.Duration) *MockReadSeeker_Seek_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) WaitUntil(w <-chan time.Time) *MockReadSeeker_Seek_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) NotBefore(calls ...*mock.Call) *MockReadSeeker_Seek_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadSeeker_Seek_Call) Unset() *MockReadSeeker_Seek_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReadSeekCloser creates a new instance of MockReadSeekCloser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReadSeekCloser(t interface {
@@ -1097,6 +1698,46 @@ func (_c *MockReadSeekCloser_Close_Call) RunAndReturn(run func() error) *MockRea
 	return _c
 }
 
+func (_c *MockReadSeekCloser_Close_Call) Once() *MockReadSeekCloser_Close_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) Twice() *MockReadSeekCloser_Close_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) Times(i int) *MockReadSeekCloser_Close_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) Maybe() *MockReadSeekCloser_Close_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) After(d time.Duration) *MockReadSeekCloser_Close_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) WaitUntil(w <-chan time.Time) *MockReadSeekCloser_Close_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) NotBefore(calls ...*mock.Call) *MockReadSeekCloser_Close_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Close_Call) Unset() *MockReadSeekCloser_Close_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Read provides a mock function for the type MockReadSeekCloser
 func (_mock *MockReadSeekCloser) Read(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -1151,6 +1792,46 @@ func (_c *MockReadSeekCloser_Read_Call) RunAndReturn(run func(p []byte) (int, er
 	return _c
 }
 
+func (_c *MockReadSeekCloser_Read_Call) Once() *MockReadSeekCloser_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) Twice() *MockReadSeekCloser_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) Times(i int) *MockReadSeekCloser_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) Maybe() *MockReadSeekCloser_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) After(d time.Duration) *MockReadSeekCloser_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) WaitUntil(w <-chan time.Time) *MockReadSeekCloser_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) NotBefore(calls ...*mock.Call) *MockReadSeekCloser_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Read_Call) Unset() *MockReadSeekCloser_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Seek provides a mock function for the type MockReadSeekCloser
 func (_mock *MockReadSeekCloser) Seek(offset int64, whence int) (int64, error) {
 	ret := _mock.Called(offset, whence)
@@ -1206,6 +1887,46 @@ func (_c *MockReadSeekCloser_Seek_Call) RunAndReturn(run func(offset int64, when
 	return _c
 }
 
+func (_c *MockReadSeekCloser_Seek_Call) Once() *MockReadSeekCloser_Seek_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) Twice() *MockReadSeekCloser_Seek_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) Times(i int) *MockReadSeekCloser_Seek_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) Maybe() *MockReadSeekCloser_Seek_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) After(d time.Duration) *MockReadSeekCloser_Seek_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) WaitUntil(w <-chan time.Time) *MockReadSeekCloser_Seek_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) NotBefore(calls ...*mock.Call) *MockReadSeekCloser_Seek_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadSeekCloser_Seek_Call) Unset() *MockReadSeekCloser_Seek_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockWriteSeeker creates a new instance of MockWriteSeeker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriteSeeker(t interface {
@@ -1288,6 +2009,46 @@ func (_c *MockWriteSeeker_Seek_Call) RunAndReturn(run func(offset int64, whence
 	return _c
 }
 
+func (_c *MockWriteSeeker_Seek_Call) Once() *MockWriteSeeker_Seek_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) Twice() *MockWriteSeeker_Seek_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) Times(i int) *MockWriteSeeker_Seek_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) Maybe() *MockWriteSeeker_Seek_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) After(d time.Duration) *MockWriteSeeker_Seek_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) WaitUntil(w <-chan time.Time) *MockWriteSeeker_Seek_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) NotBefore(calls ...*mock.Call) *MockWriteSeeker_Seek_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Seek_Call) Unset() *MockWriteSeeker_Seek_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Write provides a mock function for the type MockWriteSeeker
 func (_mock *MockWriteSeeker) Write(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -1342,6 +2103,46 @@ func (_c *MockWriteSeeker_Write_Call) RunAndReturn(run func(p []byte) (int, erro
 	return _c
 }
 
+func (_c *MockWriteSeeker_Write_Call) Once() *MockWriteSeeker_Write_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) Twice() *MockWriteSeeker_Write_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) Times(i int) *MockWriteSeeker_Write_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) Maybe() *MockWriteSeeker_Write_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) After(d time.Duration) *MockWriteSeeker_Write_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) WaitUntil(w <-chan time.Time) *MockWriteSeeker_Write_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) NotBefore(calls ...*mock.Call) *MockWriteSeeker_Write_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriteSeeker_Write_Call) Unset() *MockWriteSeeker_Write_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReadWriteSeeker creates a new instance of MockReadWriteSeeker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReadWriteSeeker(t interface {
@@ -1423,6 +2224,46 @@ func (_c *MockReadWriteSeeker_Read_Call) RunAndReturn(run func(p []byte) (int, e
 	return _c
 }
 
+func (_c *MockReadWriteSeeker_Read_Call) Once() *MockReadWriteSeeker_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) Twice() *MockReadWriteSeeker_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) Times(i int) *MockReadWriteSeeker_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) Maybe() *MockReadWriteSeeker_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) After(d time.Duration) *MockReadWriteSeeker_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) WaitUntil(w <-chan time.Time) *MockReadWriteSeeker_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) NotBefore(calls ...*mock.Call) *MockReadWriteSeeker_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Read_Call) Unset() *MockReadWriteSeeker_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Seek provides a mock function for the type MockReadWriteSeeker
 func (_mock *MockReadWriteSeeker) Seek(offset int64, whence int) (int64, error) {
 	ret := _mock.Called(offset, whence)
@@ -1478,6 +2319,46 @@ func (_c *MockReadWriteSeeker_Seek_Call) RunAndReturn(run func(offset int64, whe
 	return _c
 }
 
+func (_c *MockReadWriteSeeker_Seek_Call) Once() *MockReadWriteSeeker_Seek_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) Twice() *MockReadWriteSeeker_Seek_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) Times(i int) *MockReadWriteSeeker_Seek_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) Maybe() *MockReadWriteSeeker_Seek_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) After(d time.Duration) *MockReadWriteSeeker_Seek_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) WaitUntil(w <-chan time.Time) *MockReadWriteSeeker_Seek_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) NotBefore(calls ...*mock.Call) *MockReadWriteSeeker_Seek_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Seek_Call) Unset() *MockReadWriteSeeker_Seek_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Write provides a mock function for the type MockReadWriteSeeker
 func (_mock *MockReadWriteSeeker) Write(p []byte) (int, error) {
 	ret := _mock.Called(p)
@@ -1532,6 +2413,46 @@ func (_c *MockReadWriteSeeker_Write_Call) RunAndReturn(run func(p []byte) (int,
 	return _c
 }
 
+func (_c *MockReadWriteSeeker_Write_Call) Once() *MockReadWriteSeeker_Write_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) Twice() *MockReadWriteSeeker_Write_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) Times(i int) *MockReadWriteSeeker_Write_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) Maybe() *MockReadWriteSeeker_Write_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) After(d time.Duration) *MockReadWriteSeeker_Write_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) WaitUntil(w <-chan time.Time) *MockReadWriteSeeker_Write_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) NotBefore(calls ...*mock.Call) *MockReadWriteSeeker_Write_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReadWriteSeeker_Write_Call) Unset() *MockReadWriteSeeker_Write_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockReaderFrom creates a new instance of MockReaderFrom. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockReaderFrom(t interface {
@@ -1613,6 +2534,46 @@ func (_c *MockReaderFrom_ReadFrom_Call) RunAndReturn(run func(r io.Reader) (int6
 	return _c
 }
 
+func (_c *MockReaderFrom_ReadFrom_Call) Once() *MockReaderFrom_ReadFrom_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) Twice() *MockReaderFrom_ReadFrom_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) Times(i int) *MockReaderFrom_ReadFrom_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) Maybe() *MockReaderFrom_ReadFrom_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) After(d time.Duration) *MockReaderFrom_ReadFrom_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) WaitUntil(w <-chan time.Time) *MockReaderFrom_ReadFrom_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) NotBefore(calls ...*mock.Call) *MockReaderFrom_ReadFrom_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReaderFrom_ReadFrom_Call) Unset() *MockReaderFrom_ReadFrom_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockWriterTo creates a new instance of MockWriterTo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriterTo(t interface {
@@ -1671,26 +2632,66 @@ type MockWriterTo_WriteTo_Call struct {
 	*mock.Call
 }
 
-// WriteTo is a helper method to define mock.On call
-//   - w
-func (_e *MockWriterTo_Expecter) WriteTo(w interface{}) *MockWriterTo_WriteTo_Call {
-	return &MockWriterTo_WriteTo_Call{Call: _e.mock.On("WriteTo", w)}
+// WriteTo is a helper method to define mock.On call
+//   - w
+func (_e *MockWriterTo_Expecter) WriteTo(w interface{}) *MockWriterTo_WriteTo_Call {
+	return &MockWriterTo_WriteTo_Call{Call: _e.mock.On("WriteTo", w)}
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Run(run func(w io.Writer)) *MockWriterTo_WriteTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Return(n int64, err error) *MockWriterTo_WriteTo_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) RunAndReturn(run func(w io.Writer) (int64, error)) *MockWriterTo_WriteTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Once() *MockWriterTo_WriteTo_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Twice() *MockWriterTo_WriteTo_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Times(i int) *MockWriterTo_WriteTo_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Maybe() *MockWriterTo_WriteTo_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-func (_c *MockWriterTo_WriteTo_Call) Run(run func(w io.Writer)) *MockWriterTo_WriteTo_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(io.Writer))
-	})
+func (_c *MockWriterTo_WriteTo_Call) After(d time.Duration) *MockWriterTo_WriteTo_Call {
+	_c.Call.After(d)
 	return _c
 }
 
-func (_c *MockWriterTo_WriteTo_Call) Return(n int64, err error) *MockWriterTo_WriteTo_Call {
-	_c.Call.Return(n, err)
+func (_c *MockWriterTo_WriteTo_Call) WaitUntil(w <-chan time.Time) *MockWriterTo_WriteTo_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockWriterTo_WriteTo_Call) RunAndReturn(run func(w io.Writer) (int64, error)) *MockWriterTo_WriteTo_Call {
-	_c.Call.Return(run)
+func (_c *MockWriterTo_WriteTo_Call) NotBefore(calls ...*mock.Call) *MockWriterTo_WriteTo_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriterTo_WriteTo_Call) Unset() *MockWriterTo_WriteTo_Call {
+	_c.Call.Unset()
 	return _c
 }
 
@@ -1776,6 +2777,46 @@ func (_c *MockReaderAt_ReadAt_Call) RunAndReturn(run func(p []byte, off int64) (
 	return _c
 }
 
+func (_c *MockReaderAt_ReadAt_Call) Once() *MockReaderAt_ReadAt_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) Twice() *MockReaderAt_ReadAt_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) Times(i int) *MockReaderAt_ReadAt_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) Maybe() *MockReaderAt_ReadAt_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) After(d time.Duration) *MockReaderAt_ReadAt_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) WaitUntil(w <-chan time.Time) *MockReaderAt_ReadAt_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) NotBefore(calls ...*mock.Call) *MockReaderAt_ReadAt_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReaderAt_ReadAt_Call) Unset() *MockReaderAt_ReadAt_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockWriterAt creates a new instance of MockWriterAt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriterAt(t interface {
@@ -1858,6 +2899,46 @@ func (_c *MockWriterAt_WriteAt_Call) RunAndReturn(run func(p []byte, off int64)
 	return _c
 }
 
+func (_c *MockWriterAt_WriteAt_Call) Once() *MockWriterAt_WriteAt_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) Twice() *MockWriterAt_WriteAt_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) Times(i int) *MockWriterAt_WriteAt_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) Maybe() *MockWriterAt_WriteAt_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) After(d time.Duration) *MockWriterAt_WriteAt_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) WaitUntil(w <-chan time.Time) *MockWriterAt_WriteAt_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) NotBefore(calls ...*mock.Call) *MockWriterAt_WriteAt_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockWriterAt_WriteAt_Call) Unset() *MockWriterAt_WriteAt_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockByteReader creates a new instance of MockByteReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockByteReader(t interface {
@@ -1938,6 +3019,46 @@ func (_c *MockByteReader_ReadByte_Call) RunAndReturn(run func() (byte, error)) *
 	return _c
 }
 
+func (_c *MockByteReader_ReadByte_Call) Once() *MockByteReader_ReadByte_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) Twice() *MockByteReader_ReadByte_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) Times(i int) *MockByteReader_ReadByte_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) Maybe() *MockByteReader_ReadByte_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) After(d time.Duration) *MockByteReader_ReadByte_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) WaitUntil(w <-chan time.Time) *MockByteReader_ReadByte_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) NotBefore(calls ...*mock.Call) *MockByteReader_ReadByte_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockByteReader_ReadByte_Call) Unset() *MockByteReader_ReadByte_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockByteScanner creates a new instance of MockByteScanner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockByteScanner(t interface {
@@ -2018,6 +3139,46 @@ func (_c *MockByteScanner_ReadByte_Call) RunAndReturn(run func() (byte, error))
 	return _c
 }
 
+func (_c *MockByteScanner_ReadByte_Call) Once() *MockByteScanner_ReadByte_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) Twice() *MockByteScanner_ReadByte_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) Times(i int) *MockByteScanner_ReadByte_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) Maybe() *MockByteScanner_ReadByte_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) After(d time.Duration) *MockByteScanner_ReadByte_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) WaitUntil(w <-chan time.Time) *MockByteScanner_ReadByte_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) NotBefore(calls ...*mock.Call) *MockByteScanner_ReadByte_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockByteScanner_ReadByte_Call) Unset() *MockByteScanner_ReadByte_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // UnreadByte provides a mock function for the type MockByteScanner
 func (_mock *MockByteScanner) UnreadByte() error {
 	ret := _mock.Called()
@@ -2062,6 +3223,46 @@ func (_c *MockByteScanner_UnreadByte_Call) RunAndReturn(run func() error) *MockB
 	return _c
 }
 
+func (_c *MockByteScanner_UnreadByte_Call) Once() *MockByteScanner_UnreadByte_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) Twice() *MockByteScanner_UnreadByte_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) Times(i int) *MockByteScanner_UnreadByte_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) Maybe() *MockByteScanner_UnreadByte_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) After(d time.Duration) *MockByteScanner_UnreadByte_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) WaitUntil(w <-chan time.Time) *MockByteScanner_UnreadByte_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) NotBefore(calls ...*mock.Call) *MockByteScanner_UnreadByte_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockByteScanner_UnreadByte_Call) Unset() *MockByteScanner_UnreadByte_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockByteWriter creates a new instance of MockByteWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockByteWriter(t interface {
@@ -2134,6 +3335,46 @@ func (_c *MockByteWriter_WriteByte_Call) RunAndReturn(run func(c byte) error) *M
 	return _c
 }
 
+func (_c *MockByteWriter_WriteByte_Call) Once() *MockByteWriter_WriteByte_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) Twice() *MockByteWriter_WriteByte_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) Times(i int) *MockByteWriter_WriteByte_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) Maybe() *MockByteWriter_WriteByte_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) After(d time.Duration) *MockByteWriter_WriteByte_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) WaitUntil(w <-chan time.Time) *MockByteWriter_WriteByte_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) NotBefore(calls ...*mock.Call) *MockByteWriter_WriteByte_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockByteWriter_WriteByte_Call) Unset() *MockByteWriter_WriteByte_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockRuneReader creates a new instance of MockRuneReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRuneReader(t interface {
@@ -2220,6 +3461,46 @@ func (_c *MockRuneReader_ReadRune_Call) RunAndReturn(run func() (rune, int, erro
 	return _c
 }
 
+func (_c *MockRuneReader_ReadRune_Call) Once() *MockRuneReader_ReadRune_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) Twice() *MockRuneReader_ReadRune_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) Times(i int) *MockRuneReader_ReadRune_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) Maybe() *MockRuneReader_ReadRune_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) After(d time.Duration) *MockRuneReader_ReadRune_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) WaitUntil(w <-chan time.Time) *MockRuneReader_ReadRune_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) NotBefore(calls ...*mock.Call) *MockRuneReader_ReadRune_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRuneReader_ReadRune_Call) Unset() *MockRuneReader_ReadRune_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockRuneScanner creates a new instance of MockRuneScanner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRuneScanner(t interface {
@@ -2306,6 +3587,46 @@ func (_c *MockRuneScanner_ReadRune_Call) RunAndReturn(run func() (rune, int, err
 	return _c
 }
 
+func (_c *MockRuneScanner_ReadRune_Call) Once() *MockRuneScanner_ReadRune_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) Twice() *MockRuneScanner_ReadRune_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) Times(i int) *MockRuneScanner_ReadRune_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) Maybe() *MockRuneScanner_ReadRune_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) After(d time.Duration) *MockRuneScanner_ReadRune_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) WaitUntil(w <-chan time.Time) *MockRuneScanner_ReadRune_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) NotBefore(calls ...*mock.Call) *MockRuneScanner_ReadRune_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRuneScanner_ReadRune_Call) Unset() *MockRuneScanner_ReadRune_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // UnreadRune provides a mock function for the type MockRuneScanner
 func (_mock *MockRuneScanner) UnreadRune() error {
 	ret := _mock.Called()
@@ -2350,6 +3671,46 @@ func (_c *MockRuneScanner_UnreadRune_Call) RunAndReturn(run func() error) *MockR
 	return _c
 }
 
+func (_c *MockRuneScanner_UnreadRune_Call) Once() *MockRuneScanner_UnreadRune_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) Twice() *MockRuneScanner_UnreadRune_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) Times(i int) *MockRuneScanner_UnreadRune_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) Maybe() *MockRuneScanner_UnreadRune_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) After(d time.Duration) *MockRuneScanner_UnreadRune_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) WaitUntil(w <-chan time.Time) *MockRuneScanner_UnreadRune_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) NotBefore(calls ...*mock.Call) *MockRuneScanner_UnreadRune_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRuneScanner_UnreadRune_Call) Unset() *MockRuneScanner_UnreadRune_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockStringWriter creates a new instance of MockStringWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStringWriter(t interface {
@@ -2430,3 +3791,43 @@ func (_c *MockStringWriter_WriteString_Call) RunAndReturn(run func(s string) (in
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockStringWriter_WriteString_Call) Once() *MockStringWriter_WriteString_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) Twice() *MockStringWriter_WriteString_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) Times(i int) *MockStringWriter_WriteString_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) Maybe() *MockStringWriter_WriteString_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) After(d time.Duration) *MockStringWriter_WriteString_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) WaitUntil(w <-chan time.Time) *MockStringWriter_WriteString_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) NotBefore(calls ...*mock.Call) *MockStringWriter_WriteString_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockStringWriter_WriteString_Call) Unset() *MockStringWriter_WriteString_Call {
+	_c.Call.Unset()
+	return _c
+}
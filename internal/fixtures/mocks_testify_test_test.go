@@ -6,16 +6,22 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
+	"sync"
+	"time"
 	"unsafe"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/vektra/mockery/v3/arg"
+	"github.com/vektra/mockery/v3/await"
 	http1 "github.com/vektra/mockery/v3/internal/fixtures/12345678/http"
 	"github.com/vektra/mockery/v3/internal/fixtures/constraints"
 	http0 "github.com/vektra/mockery/v3/internal/fixtures/http"
 	test "github.com/vektra/mockery/v3/internal/fixtures/redefined_type_b"
+	"github.com/vektra/mockery/v3/sequence"
 )
 
 // NewMockUsesAny creates a new instance of MockUsesAny. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -91,6 +97,46 @@ func (_c *MockUsesAny_GetReader_Call) RunAndReturn(run func() any) *MockUsesAny_
 	return _c
 }
 
+func (_c *MockUsesAny_GetReader_Call) Once() *MockUsesAny_GetReader_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) Twice() *MockUsesAny_GetReader_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) Times(i int) *MockUsesAny_GetReader_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) Maybe() *MockUsesAny_GetReader_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) After(d time.Duration) *MockUsesAny_GetReader_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) WaitUntil(w <-chan time.Time) *MockUsesAny_GetReader_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) NotBefore(calls ...*mock.Call) *MockUsesAny_GetReader_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockUsesAny_GetReader_Call) Unset() *MockUsesAny_GetReader_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockFooer creates a new instance of MockFooer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockFooer(t interface {
@@ -152,6 +198,46 @@ func (_c *MockFooer_Bar_Call) RunAndReturn(run func(f func([]int))) *MockFooer_B
 	return _c
 }
 
+func (_c *MockFooer_Bar_Call) Once() *MockFooer_Bar_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) Twice() *MockFooer_Bar_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) Times(i int) *MockFooer_Bar_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) Maybe() *MockFooer_Bar_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) After(d time.Duration) *MockFooer_Bar_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) WaitUntil(w <-chan time.Time) *MockFooer_Bar_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) NotBefore(calls ...*mock.Call) *MockFooer_Bar_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockFooer_Bar_Call) Unset() *MockFooer_Bar_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Baz provides a mock function for the type MockFooer
 func (_mock *MockFooer) Baz(path string) func(x string) string {
 	ret := _mock.Called(path)
@@ -199,6 +285,46 @@ func (_c *MockFooer_Baz_Call) RunAndReturn(run func(path string) func(x string)
 	return _c
 }
 
+func (_c *MockFooer_Baz_Call) Once() *MockFooer_Baz_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) Twice() *MockFooer_Baz_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) Times(i int) *MockFooer_Baz_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) Maybe() *MockFooer_Baz_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) After(d time.Duration) *MockFooer_Baz_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) WaitUntil(w <-chan time.Time) *MockFooer_Baz_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) NotBefore(calls ...*mock.Call) *MockFooer_Baz_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockFooer_Baz_Call) Unset() *MockFooer_Baz_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Foo provides a mock function for the type MockFooer
 func (_mock *MockFooer) Foo(f func(x string) string) error {
 	ret := _mock.Called(f)
@@ -244,6 +370,46 @@ func (_c *MockFooer_Foo_Call) RunAndReturn(run func(f func(x string) string) err
 	return _c
 }
 
+func (_c *MockFooer_Foo_Call) Once() *MockFooer_Foo_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) Twice() *MockFooer_Foo_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) Times(i int) *MockFooer_Foo_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) Maybe() *MockFooer_Foo_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) After(d time.Duration) *MockFooer_Foo_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) WaitUntil(w <-chan time.Time) *MockFooer_Foo_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) NotBefore(calls ...*mock.Call) *MockFooer_Foo_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockFooer_Foo_Call) Unset() *MockFooer_Foo_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockMapFunc creates a new instance of MockMapFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMapFunc(t interface {
@@ -316,6 +482,46 @@ func (_c *MockMapFunc_Get_Call) RunAndReturn(run func(m map[string]func(string)
 	return _c
 }
 
+func (_c *MockMapFunc_Get_Call) Once() *MockMapFunc_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) Twice() *MockMapFunc_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) Times(i int) *MockMapFunc_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) Maybe() *MockMapFunc_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) After(d time.Duration) *MockMapFunc_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) WaitUntil(w <-chan time.Time) *MockMapFunc_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) NotBefore(calls ...*mock.Call) *MockMapFunc_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockMapFunc_Get_Call) Unset() *MockMapFunc_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockAsyncProducer creates a new instance of MockAsyncProducer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAsyncProducer(t interface {
@@ -389,6 +595,46 @@ func (_c *MockAsyncProducer_Input_Call) RunAndReturn(run func() chan<- bool) *Mo
 	return _c
 }
 
+func (_c *MockAsyncProducer_Input_Call) Once() *MockAsyncProducer_Input_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) Twice() *MockAsyncProducer_Input_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) Times(i int) *MockAsyncProducer_Input_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) Maybe() *MockAsyncProducer_Input_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) After(d time.Duration) *MockAsyncProducer_Input_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) WaitUntil(w <-chan time.Time) *MockAsyncProducer_Input_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) NotBefore(calls ...*mock.Call) *MockAsyncProducer_Input_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Input_Call) Unset() *MockAsyncProducer_Input_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Output provides a mock function for the type MockAsyncProducer
 func (_mock *MockAsyncProducer) Output() <-chan bool {
 	ret := _mock.Called()
@@ -435,6 +681,46 @@ func (_c *MockAsyncProducer_Output_Call) RunAndReturn(run func() <-chan bool) *M
 	return _c
 }
 
+func (_c *MockAsyncProducer_Output_Call) Once() *MockAsyncProducer_Output_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) Twice() *MockAsyncProducer_Output_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) Times(i int) *MockAsyncProducer_Output_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) Maybe() *MockAsyncProducer_Output_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) After(d time.Duration) *MockAsyncProducer_Output_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) WaitUntil(w <-chan time.Time) *MockAsyncProducer_Output_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) NotBefore(calls ...*mock.Call) *MockAsyncProducer_Output_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Output_Call) Unset() *MockAsyncProducer_Output_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // Whatever provides a mock function for the type MockAsyncProducer
 func (_mock *MockAsyncProducer) Whatever() chan bool {
 	ret := _mock.Called()
@@ -481,13 +767,53 @@ func (_c *MockAsyncProducer_Whatever_Call) RunAndReturn(run func() chan bool) *M
 	return _c
 }
 
-// NewMockConsulLock creates a new instance of MockConsulLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func (_c *MockAsyncProducer_Whatever_Call) Once() *MockAsyncProducer_Whatever_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) Twice() *MockAsyncProducer_Whatever_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) Times(i int) *MockAsyncProducer_Whatever_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) Maybe() *MockAsyncProducer_Whatever_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) After(d time.Duration) *MockAsyncProducer_Whatever_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) WaitUntil(w <-chan time.Time) *MockAsyncProducer_Whatever_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) NotBefore(calls ...*mock.Call) *MockAsyncProducer_Whatever_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockAsyncProducer_Whatever_Call) Unset() *MockAsyncProducer_Whatever_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockAuthenticator creates a new instance of MockAuthenticator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockConsulLock(t interface {
+func NewMockAuthenticator(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockConsulLock {
-	mock := &MockConsulLock{}
+}) *MockAuthenticator {
+	mock := &MockAuthenticator{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -495,284 +821,282 @@ func NewMockConsulLock(t interface {
 	return mock
 }
 
-// MockConsulLock is an autogenerated mock type for the ConsulLock type
-type MockConsulLock struct {
+// MockAuthenticator is an autogenerated mock type for the Authenticator type
+type MockAuthenticator struct {
 	mock.Mock
 }
 
-type MockConsulLock_Expecter struct {
+type MockAuthenticator_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockConsulLock) EXPECT() *MockConsulLock_Expecter {
-	return &MockConsulLock_Expecter{mock: &_m.Mock}
+func (_m *MockAuthenticator) EXPECT() *MockAuthenticator_Expecter {
+	return &MockAuthenticator_Expecter{mock: &_m.Mock}
 }
 
-// Lock provides a mock function for the type MockConsulLock
-func (_mock *MockConsulLock) Lock(valCh <-chan struct{}) (<-chan struct{}, error) {
-	ret := _mock.Called(valCh)
+// Login provides a mock function for the type MockAuthenticator
+func (_mock *MockAuthenticator) Login(ctx context.Context, user string, secret string) (string, error) {
+	ret := _mock.Called(ctx, user, secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Lock")
+		panic("no return value specified for Login")
 	}
 
-	var r0 <-chan struct{}
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(<-chan struct{}) (<-chan struct{}, error)); ok {
-		return returnFunc(valCh)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, user, secret)
 	}
-	if returnFunc, ok := ret.Get(0).(func(<-chan struct{}) <-chan struct{}); ok {
-		r0 = returnFunc(valCh)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, user, secret)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(<-chan struct{})
-		}
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(<-chan struct{}) error); ok {
-		r1 = returnFunc(valCh)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, user, secret)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockConsulLock_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
-type MockConsulLock_Lock_Call struct {
+// MockAuthenticator_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthenticator_Login_Call struct {
 	*mock.Call
 }
 
-// Lock is a helper method to define mock.On call
-//   - valCh
-func (_e *MockConsulLock_Expecter) Lock(valCh interface{}) *MockConsulLock_Lock_Call {
-	return &MockConsulLock_Lock_Call{Call: _e.mock.On("Lock", valCh)}
+// Login is a helper method to define mock.On call
+//   - ctx
+//   - user
+//   - secret
+func (_e *MockAuthenticator_Expecter) Login(ctx interface{}, user interface{}, secret interface{}) *MockAuthenticator_Login_Call {
+	return &MockAuthenticator_Login_Call{Call: _e.mock.On("Login", ctx, user, secret)}
 }
 
-func (_c *MockConsulLock_Lock_Call) Run(run func(valCh <-chan struct{})) *MockConsulLock_Lock_Call {
+func (_c *MockAuthenticator_Login_Call) Run(run func(ctx context.Context, user string, secret string)) *MockAuthenticator_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(<-chan struct{}))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockConsulLock_Lock_Call) Return(valCh1 <-chan struct{}, err error) *MockConsulLock_Lock_Call {
-	_c.Call.Return(valCh1, err)
+func (_c *MockAuthenticator_Login_Call) Return(token string, err error) *MockAuthenticator_Login_Call {
+	_c.Call.Return(token, err)
 	return _c
 }
 
-func (_c *MockConsulLock_Lock_Call) RunAndReturn(run func(valCh <-chan struct{}) (<-chan struct{}, error)) *MockConsulLock_Lock_Call {
+func (_c *MockAuthenticator_Login_Call) RunAndReturn(run func(ctx context.Context, user string, secret string) (string, error)) *MockAuthenticator_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Unlock provides a mock function for the type MockConsulLock
-func (_mock *MockConsulLock) Unlock() error {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for Unlock")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+func (_c *MockAuthenticator_Login_Call) Once() *MockAuthenticator_Login_Call {
+	_c.Call.Once()
+	return _c
 }
 
-// MockConsulLock_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
-type MockConsulLock_Unlock_Call struct {
-	*mock.Call
+func (_c *MockAuthenticator_Login_Call) Twice() *MockAuthenticator_Login_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// Unlock is a helper method to define mock.On call
-func (_e *MockConsulLock_Expecter) Unlock() *MockConsulLock_Unlock_Call {
-	return &MockConsulLock_Unlock_Call{Call: _e.mock.On("Unlock")}
+func (_c *MockAuthenticator_Login_Call) Times(i int) *MockAuthenticator_Login_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-func (_c *MockConsulLock_Unlock_Call) Run(run func()) *MockConsulLock_Unlock_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+func (_c *MockAuthenticator_Login_Call) Maybe() *MockAuthenticator_Login_Call {
+	_c.Call.Maybe()
 	return _c
 }
 
-func (_c *MockConsulLock_Unlock_Call) Return(err error) *MockConsulLock_Unlock_Call {
-	_c.Call.Return(err)
+func (_c *MockAuthenticator_Login_Call) After(d time.Duration) *MockAuthenticator_Login_Call {
+	_c.Call.After(d)
 	return _c
 }
 
-func (_c *MockConsulLock_Unlock_Call) RunAndReturn(run func() error) *MockConsulLock_Unlock_Call {
-	_c.Call.Return(run)
+func (_c *MockAuthenticator_Login_Call) WaitUntil(w <-chan time.Time) *MockAuthenticator_Login_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-// NewMockKeyManager creates a new instance of MockKeyManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockKeyManager(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockKeyManager {
-	mock := &MockKeyManager{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockKeyManager is an autogenerated mock type for the KeyManager type
-type MockKeyManager struct {
-	mock.Mock
-}
-
-type MockKeyManager_Expecter struct {
-	mock *mock.Mock
+func (_c *MockAuthenticator_Login_Call) NotBefore(calls ...*mock.Call) *MockAuthenticator_Login_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
 }
 
-func (_m *MockKeyManager) EXPECT() *MockKeyManager_Expecter {
-	return &MockKeyManager_Expecter{mock: &_m.Mock}
+func (_c *MockAuthenticator_Login_Call) Unset() *MockAuthenticator_Login_Call {
+	_c.Call.Unset()
+	return _c
 }
 
-// GetKey provides a mock function for the type MockKeyManager
-func (_mock *MockKeyManager) GetKey(s string, v uint16) ([]byte, *Err) {
-	ret := _mock.Called(s, v)
+// Logout provides a mock function for the type MockAuthenticator
+func (_mock *MockAuthenticator) Logout(ctx context.Context, token string) error {
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetKey")
+		panic("no return value specified for Logout")
 	}
 
-	var r0 []byte
-	var r1 *Err
-	if returnFunc, ok := ret.Get(0).(func(string, uint16) ([]byte, *Err)); ok {
-		return returnFunc(s, v)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string, uint16) []byte); ok {
-		r0 = returnFunc(s, v)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]byte)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string, uint16) *Err); ok {
-		r1 = returnFunc(s, v)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*Err)
-		}
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockKeyManager_GetKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKey'
-type MockKeyManager_GetKey_Call struct {
+// MockAuthenticator_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthenticator_Logout_Call struct {
 	*mock.Call
 }
 
-// GetKey is a helper method to define mock.On call
-//   - s
-//   - v
-func (_e *MockKeyManager_Expecter) GetKey(s interface{}, v interface{}) *MockKeyManager_GetKey_Call {
-	return &MockKeyManager_GetKey_Call{Call: _e.mock.On("GetKey", s, v)}
+// Logout is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockAuthenticator_Expecter) Logout(ctx interface{}, token interface{}) *MockAuthenticator_Logout_Call {
+	return &MockAuthenticator_Logout_Call{Call: _e.mock.On("Logout", ctx, token)}
 }
 
-func (_c *MockKeyManager_GetKey_Call) Run(run func(s string, v uint16)) *MockKeyManager_GetKey_Call {
+func (_c *MockAuthenticator_Logout_Call) Run(run func(ctx context.Context, token string)) *MockAuthenticator_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(uint16))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockKeyManager_GetKey_Call) Return(bytes []byte, err *Err) *MockKeyManager_GetKey_Call {
-	_c.Call.Return(bytes, err)
+func (_c *MockAuthenticator_Logout_Call) Return(err error) *MockAuthenticator_Logout_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockKeyManager_GetKey_Call) RunAndReturn(run func(s string, v uint16) ([]byte, *Err)) *MockKeyManager_GetKey_Call {
+func (_c *MockAuthenticator_Logout_Call) RunAndReturn(run func(ctx context.Context, token string) error) *MockAuthenticator_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockBlank creates a new instance of MockBlank. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockBlank(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockBlank {
-	mock := &MockBlank{}
-	mock.Mock.Test(t)
+func (_c *MockAuthenticator_Logout_Call) Once() *MockAuthenticator_Logout_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockAuthenticator_Logout_Call) Twice() *MockAuthenticator_Logout_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockAuthenticator_Logout_Call) Times(i int) *MockAuthenticator_Logout_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockBlank is an autogenerated mock type for the Blank type
-type MockBlank struct {
-	mock.Mock
+func (_c *MockAuthenticator_Logout_Call) Maybe() *MockAuthenticator_Logout_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockBlank_Expecter struct {
-	mock *mock.Mock
+func (_c *MockAuthenticator_Logout_Call) After(d time.Duration) *MockAuthenticator_Logout_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockBlank) EXPECT() *MockBlank_Expecter {
-	return &MockBlank_Expecter{mock: &_m.Mock}
+func (_c *MockAuthenticator_Logout_Call) WaitUntil(w <-chan time.Time) *MockAuthenticator_Logout_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Create provides a mock function for the type MockBlank
-func (_mock *MockBlank) Create(x interface{}) error {
-	ret := _mock.Called(x)
+func (_c *MockAuthenticator_Logout_Call) NotBefore(calls ...*mock.Call) *MockAuthenticator_Logout_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for Create")
-	}
+func (_c *MockAuthenticator_Logout_Call) Unset() *MockAuthenticator_Logout_Call {
+	_c.Call.Unset()
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(interface{}) error); ok {
-		r0 = returnFunc(x)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// Ping provides a mock function for the type MockAuthenticator
+func (_mock *MockAuthenticator) Ping(n int) {
+	_mock.Called(n)
+	return
 }
 
-// MockBlank_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockBlank_Create_Call struct {
+// MockAuthenticator_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockAuthenticator_Ping_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - x
-func (_e *MockBlank_Expecter) Create(x interface{}) *MockBlank_Create_Call {
-	return &MockBlank_Create_Call{Call: _e.mock.On("Create", x)}
+// Ping is a helper method to define mock.On call
+//   - n
+func (_e *MockAuthenticator_Expecter) Ping(n interface{}) *MockAuthenticator_Ping_Call {
+	return &MockAuthenticator_Ping_Call{Call: _e.mock.On("Ping", n)}
 }
 
-func (_c *MockBlank_Create_Call) Run(run func(x interface{})) *MockBlank_Create_Call {
+func (_c *MockAuthenticator_Ping_Call) Run(run func(n int)) *MockAuthenticator_Ping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(interface{}))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *MockBlank_Create_Call) Return(err error) *MockBlank_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockAuthenticator_Ping_Call) Return() *MockAuthenticator_Ping_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockBlank_Create_Call) RunAndReturn(run func(x interface{}) error) *MockBlank_Create_Call {
-	_c.Call.Return(run)
+func (_c *MockAuthenticator_Ping_Call) RunAndReturn(run func(n int)) *MockAuthenticator_Ping_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockExpecterAndRolledVariadic creates a new instance of MockExpecterAndRolledVariadic. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func (_c *MockAuthenticator_Ping_Call) Once() *MockAuthenticator_Ping_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) Twice() *MockAuthenticator_Ping_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) Times(i int) *MockAuthenticator_Ping_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) Maybe() *MockAuthenticator_Ping_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) After(d time.Duration) *MockAuthenticator_Ping_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) WaitUntil(w <-chan time.Time) *MockAuthenticator_Ping_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) NotBefore(calls ...*mock.Call) *MockAuthenticator_Ping_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockAuthenticator_Ping_Call) Unset() *MockAuthenticator_Ping_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockAuthenticatorPartial creates a new instance of MockAuthenticatorPartial. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockExpecterAndRolledVariadic(t interface {
+func NewMockAuthenticatorPartial(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockExpecterAndRolledVariadic {
-	mock := &MockExpecterAndRolledVariadic{}
+}) *MockAuthenticatorPartial {
+	mock := &MockAuthenticatorPartial{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -780,279 +1104,339 @@ func NewMockExpecterAndRolledVariadic(t interface {
 	return mock
 }
 
-// MockExpecterAndRolledVariadic is an autogenerated mock type for the Expecter type
-type MockExpecterAndRolledVariadic struct {
+// NewMockAuthenticatorPartialWithDelegate creates a new instance of MockAuthenticatorPartial like NewMockAuthenticatorPartial.
+// Calls that match no expectation are forwarded to delegate, and still recorded.
+func NewMockAuthenticatorPartialWithDelegate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}, delegate Authenticator) *MockAuthenticatorPartial {
+	mock := NewMockAuthenticatorPartial(t)
+	mock.delegate = delegate
+	return mock
+}
+
+// MockAuthenticatorPartial is an autogenerated mock type for the Authenticator type
+type MockAuthenticatorPartial struct {
 	mock.Mock
+	delegate   Authenticator
+	delegateMu sync.Mutex
+	delegated  map[*mock.Call]bool
 }
 
-type MockExpecterAndRolledVariadic_Expecter struct {
+type MockAuthenticatorPartial_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockExpecterAndRolledVariadic) EXPECT() *MockExpecterAndRolledVariadic_Expecter {
-	return &MockExpecterAndRolledVariadic_Expecter{mock: &_m.Mock}
+func (_m *MockAuthenticatorPartial) EXPECT() *MockAuthenticatorPartial_Expecter {
+	return &MockAuthenticatorPartial_Expecter{mock: &_m.Mock}
 }
 
-// ManyArgsReturns provides a mock function for the type MockExpecterAndRolledVariadic
-func (_mock *MockExpecterAndRolledVariadic) ManyArgsReturns(str string, i int) ([]string, error) {
-	ret := _mock.Called(str, i)
+// _delegateCall reports whether a call should be forwarded to the delegate, which
+// is the case when there is one and no expectation matches the arguments, or all
+// the matching ones are used up. The
+// forwarded call is recorded through a one-off expectation, so that assertions
+// on the calls of the mock still see it.
+func (_mock *MockAuthenticatorPartial) _delegateCall(method string, args ...interface{}) bool {
+	if _mock.delegate == nil {
+		return false
+	}
+	_mock.delegateMu.Lock()
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.delegated[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			_mock.delegateMu.Unlock()
+			return false
+		}
+	}
+	anything := make([]interface{}, len(args))
+	for i := range anything {
+		anything[i] = mock.Anything
+	}
+	if _mock.delegated == nil {
+		_mock.delegated = make(map[*mock.Call]bool)
+	}
+	_mock.delegated[_mock.On(method, anything...).Once().Maybe()] = true
+	_mock.delegateMu.Unlock()
+
+	_mock.MethodCalled(method, args...)
+	return true
+}
+
+// Login provides a mock function for the type MockAuthenticatorPartial
+func (_mock *MockAuthenticatorPartial) Login(ctx context.Context, user string, secret string) (string, error) {
+	if _mock._delegateCall("Login", ctx, user, secret) {
+		return _mock.delegate.Login(ctx, user, secret)
+	}
+	ret := _mock.Called(ctx, user, secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ManyArgsReturns")
+		panic("no return value specified for Login")
 	}
 
-	var r0 []string
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, int) ([]string, error)); ok {
-		return returnFunc(str, i)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, user, secret)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, int) []string); ok {
-		r0 = returnFunc(str, i)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, user, secret)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = returnFunc(str, i)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, user, secret)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockExpecterAndRolledVariadic_ManyArgsReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyArgsReturns'
-type MockExpecterAndRolledVariadic_ManyArgsReturns_Call struct {
+// MockAuthenticatorPartial_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthenticatorPartial_Login_Call struct {
 	*mock.Call
 }
 
-// ManyArgsReturns is a helper method to define mock.On call
-//   - str
-//   - i
-func (_e *MockExpecterAndRolledVariadic_Expecter) ManyArgsReturns(str interface{}, i interface{}) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
-	return &MockExpecterAndRolledVariadic_ManyArgsReturns_Call{Call: _e.mock.On("ManyArgsReturns", str, i)}
+// Login is a helper method to define mock.On call
+//   - ctx
+//   - user
+//   - secret
+func (_e *MockAuthenticatorPartial_Expecter) Login(ctx interface{}, user interface{}, secret interface{}) *MockAuthenticatorPartial_Login_Call {
+	return &MockAuthenticatorPartial_Login_Call{Call: _e.mock.On("Login", ctx, user, secret)}
 }
 
-func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Run(run func(str string, i int)) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+func (_c *MockAuthenticatorPartial_Login_Call) Run(run func(ctx context.Context, user string, secret string)) *MockAuthenticatorPartial_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Return(strs []string, err error) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
-	_c.Call.Return(strs, err)
+func (_c *MockAuthenticatorPartial_Login_Call) Return(token string, err error) *MockAuthenticatorPartial_Login_Call {
+	_c.Call.Return(token, err)
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) RunAndReturn(run func(str string, i int) ([]string, error)) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+func (_c *MockAuthenticatorPartial_Login_Call) RunAndReturn(run func(ctx context.Context, user string, secret string) (string, error)) *MockAuthenticatorPartial_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NoArg provides a mock function for the type MockExpecterAndRolledVariadic
-func (_mock *MockExpecterAndRolledVariadic) NoArg() string {
-	ret := _mock.Called()
+func (_c *MockAuthenticatorPartial_Login_Call) Once() *MockAuthenticatorPartial_Login_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) Twice() *MockAuthenticatorPartial_Login_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) Times(i int) *MockAuthenticatorPartial_Login_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) Maybe() *MockAuthenticatorPartial_Login_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) After(d time.Duration) *MockAuthenticatorPartial_Login_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) WaitUntil(w <-chan time.Time) *MockAuthenticatorPartial_Login_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) NotBefore(calls ...*mock.Call) *MockAuthenticatorPartial_Login_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockAuthenticatorPartial_Login_Call) Unset() *MockAuthenticatorPartial_Login_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Logout provides a mock function for the type MockAuthenticatorPartial
+func (_mock *MockAuthenticatorPartial) Logout(ctx context.Context, token string) error {
+	if _mock._delegateCall("Logout", ctx, token) {
+		return _mock.delegate.Logout(ctx, token)
+	}
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NoArg")
+		panic("no return value specified for Logout")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func() string); ok {
-		r0 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockExpecterAndRolledVariadic_NoArg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoArg'
-type MockExpecterAndRolledVariadic_NoArg_Call struct {
+// MockAuthenticatorPartial_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthenticatorPartial_Logout_Call struct {
 	*mock.Call
 }
 
-// NoArg is a helper method to define mock.On call
-func (_e *MockExpecterAndRolledVariadic_Expecter) NoArg() *MockExpecterAndRolledVariadic_NoArg_Call {
-	return &MockExpecterAndRolledVariadic_NoArg_Call{Call: _e.mock.On("NoArg")}
+// Logout is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockAuthenticatorPartial_Expecter) Logout(ctx interface{}, token interface{}) *MockAuthenticatorPartial_Logout_Call {
+	return &MockAuthenticatorPartial_Logout_Call{Call: _e.mock.On("Logout", ctx, token)}
 }
 
-func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Run(run func()) *MockExpecterAndRolledVariadic_NoArg_Call {
+func (_c *MockAuthenticatorPartial_Logout_Call) Run(run func(ctx context.Context, token string)) *MockAuthenticatorPartial_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Return(s string) *MockExpecterAndRolledVariadic_NoArg_Call {
-	_c.Call.Return(s)
+func (_c *MockAuthenticatorPartial_Logout_Call) Return(err error) *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_NoArg_Call) RunAndReturn(run func() string) *MockExpecterAndRolledVariadic_NoArg_Call {
+func (_c *MockAuthenticatorPartial_Logout_Call) RunAndReturn(run func(ctx context.Context, token string) error) *MockAuthenticatorPartial_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NoReturn provides a mock function for the type MockExpecterAndRolledVariadic
-func (_mock *MockExpecterAndRolledVariadic) NoReturn(str string) {
-	_mock.Called(str)
-	return
+func (_c *MockAuthenticatorPartial_Logout_Call) Once() *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.Once()
+	return _c
 }
 
-// MockExpecterAndRolledVariadic_NoReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoReturn'
-type MockExpecterAndRolledVariadic_NoReturn_Call struct {
-	*mock.Call
+func (_c *MockAuthenticatorPartial_Logout_Call) Twice() *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// NoReturn is a helper method to define mock.On call
-//   - str
-func (_e *MockExpecterAndRolledVariadic_Expecter) NoReturn(str interface{}) *MockExpecterAndRolledVariadic_NoReturn_Call {
-	return &MockExpecterAndRolledVariadic_NoReturn_Call{Call: _e.mock.On("NoReturn", str)}
+func (_c *MockAuthenticatorPartial_Logout_Call) Times(i int) *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Run(run func(str string)) *MockExpecterAndRolledVariadic_NoReturn_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
+func (_c *MockAuthenticatorPartial_Logout_Call) Maybe() *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.Maybe()
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Return() *MockExpecterAndRolledVariadic_NoReturn_Call {
-	_c.Call.Return()
+func (_c *MockAuthenticatorPartial_Logout_Call) After(d time.Duration) *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.After(d)
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) RunAndReturn(run func(str string)) *MockExpecterAndRolledVariadic_NoReturn_Call {
-	_c.Run(run)
+func (_c *MockAuthenticatorPartial_Logout_Call) WaitUntil(w <-chan time.Time) *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-// Variadic provides a mock function for the type MockExpecterAndRolledVariadic
-func (_mock *MockExpecterAndRolledVariadic) Variadic(ints ...int) error {
-	var tmpRet mock.Arguments
-	if len(ints) > 0 {
-		tmpRet = _mock.Called(ints)
-	} else {
-		tmpRet = _mock.Called()
-	}
-	ret := tmpRet
+func (_c *MockAuthenticatorPartial_Logout_Call) NotBefore(calls ...*mock.Call) *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for Variadic")
-	}
+func (_c *MockAuthenticatorPartial_Logout_Call) Unset() *MockAuthenticatorPartial_Logout_Call {
+	_c.Call.Unset()
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(...int) error); ok {
-		r0 = returnFunc(ints...)
-	} else {
-		r0 = ret.Error(0)
+// Ping provides a mock function for the type MockAuthenticatorPartial
+func (_mock *MockAuthenticatorPartial) Ping(n int) {
+	if _mock._delegateCall("Ping", n) {
+		_mock.delegate.Ping(n)
+		return
 	}
-	return r0
+	_mock.Called(n)
+	return
 }
 
-// MockExpecterAndRolledVariadic_Variadic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Variadic'
-type MockExpecterAndRolledVariadic_Variadic_Call struct {
+// MockAuthenticatorPartial_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockAuthenticatorPartial_Ping_Call struct {
 	*mock.Call
 }
 
-// Variadic is a helper method to define mock.On call
-//   - ints
-func (_e *MockExpecterAndRolledVariadic_Expecter) Variadic(ints ...interface{}) *MockExpecterAndRolledVariadic_Variadic_Call {
-	return &MockExpecterAndRolledVariadic_Variadic_Call{Call: _e.mock.On("Variadic",
-		append([]interface{}{}, ints...)...)}
+// Ping is a helper method to define mock.On call
+//   - n
+func (_e *MockAuthenticatorPartial_Expecter) Ping(n interface{}) *MockAuthenticatorPartial_Ping_Call {
+	return &MockAuthenticatorPartial_Ping_Call{Call: _e.mock.On("Ping", n)}
 }
 
-func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Run(run func(ints ...int)) *MockExpecterAndRolledVariadic_Variadic_Call {
+func (_c *MockAuthenticatorPartial_Ping_Call) Run(run func(n int)) *MockAuthenticatorPartial_Ping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]int, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(int)
-			}
-		}
-		run(variadicArgs...)
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Return(err error) *MockExpecterAndRolledVariadic_Variadic_Call {
-	_c.Call.Return(err)
+func (_c *MockAuthenticatorPartial_Ping_Call) Return() *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_Variadic_Call) RunAndReturn(run func(ints ...int) error) *MockExpecterAndRolledVariadic_Variadic_Call {
-	_c.Call.Return(run)
+func (_c *MockAuthenticatorPartial_Ping_Call) RunAndReturn(run func(n int)) *MockAuthenticatorPartial_Ping_Call {
+	_c.Run(run)
 	return _c
 }
 
-// VariadicMany provides a mock function for the type MockExpecterAndRolledVariadic
-func (_mock *MockExpecterAndRolledVariadic) VariadicMany(i int, a string, intfs ...interface{}) error {
-	var tmpRet mock.Arguments
-	if len(intfs) > 0 {
-		tmpRet = _mock.Called(i, a, intfs)
-	} else {
-		tmpRet = _mock.Called(i, a)
-	}
-	ret := tmpRet
+func (_c *MockAuthenticatorPartial_Ping_Call) Once() *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for VariadicMany")
-	}
+func (_c *MockAuthenticatorPartial_Ping_Call) Twice() *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, ...interface{}) error); ok {
-		r0 = returnFunc(i, a, intfs...)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+func (_c *MockAuthenticatorPartial_Ping_Call) Times(i int) *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockExpecterAndRolledVariadic_VariadicMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VariadicMany'
-type MockExpecterAndRolledVariadic_VariadicMany_Call struct {
-	*mock.Call
+func (_c *MockAuthenticatorPartial_Ping_Call) Maybe() *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// VariadicMany is a helper method to define mock.On call
-//   - i
-//   - a
-//   - intfs
-func (_e *MockExpecterAndRolledVariadic_Expecter) VariadicMany(i interface{}, a interface{}, intfs ...interface{}) *MockExpecterAndRolledVariadic_VariadicMany_Call {
-	return &MockExpecterAndRolledVariadic_VariadicMany_Call{Call: _e.mock.On("VariadicMany",
-		append([]interface{}{i, a}, intfs...)...)}
+func (_c *MockAuthenticatorPartial_Ping_Call) After(d time.Duration) *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Run(run func(i int, a string, intfs ...interface{})) *MockExpecterAndRolledVariadic_VariadicMany_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]interface{}, len(args)-2)
-		for i, a := range args[2:] {
-			if a != nil {
-				variadicArgs[i] = a.(interface{})
-			}
-		}
-		run(args[0].(int), args[1].(string), variadicArgs...)
-	})
+func (_c *MockAuthenticatorPartial_Ping_Call) WaitUntil(w <-chan time.Time) *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Return(err error) *MockExpecterAndRolledVariadic_VariadicMany_Call {
-	_c.Call.Return(err)
+func (_c *MockAuthenticatorPartial_Ping_Call) NotBefore(calls ...*mock.Call) *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) RunAndReturn(run func(i int, a string, intfs ...interface{}) error) *MockExpecterAndRolledVariadic_VariadicMany_Call {
-	_c.Call.Return(run)
+func (_c *MockAuthenticatorPartial_Ping_Call) Unset() *MockAuthenticatorPartial_Ping_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockExpecter creates a new instance of MockExpecter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuthenticatorInOrder creates a new instance of MockAuthenticatorInOrder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockExpecter(t interface {
+func NewMockAuthenticatorInOrder(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockExpecter {
-	mock := &MockExpecter{}
+}) *MockAuthenticatorInOrder {
+	mock := &MockAuthenticatorInOrder{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1060,350 +1444,310 @@ func NewMockExpecter(t interface {
 	return mock
 }
 
-// MockExpecter is an autogenerated mock type for the Expecter type
-type MockExpecter struct {
+// MockAuthenticatorInOrder is an autogenerated mock type for the Authenticator type
+type MockAuthenticatorInOrder struct {
 	mock.Mock
+	seq *sequence.Sequence
 }
 
-type MockExpecter_Expecter struct {
+type MockAuthenticatorInOrder_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockExpecter) EXPECT() *MockExpecter_Expecter {
-	return &MockExpecter_Expecter{mock: &_m.Mock}
+func (_m *MockAuthenticatorInOrder) EXPECT() *MockAuthenticatorInOrder_Expecter {
+	return &MockAuthenticatorInOrder_Expecter{mock: &_m.Mock}
 }
 
-// ManyArgsReturns provides a mock function for the type MockExpecter
-func (_mock *MockExpecter) ManyArgsReturns(str string, i int) ([]string, error) {
-	ret := _mock.Called(str, i)
+// InSequence makes the mock record its calls in seq, which may be shared with
+// other mocks. See sequence.Sequence.AssertOrder.
+func (_mock *MockAuthenticatorInOrder) InSequence(seq *sequence.Sequence) {
+	_mock.seq = seq
+}
+
+// Login provides a mock function for the type MockAuthenticatorInOrder
+func (_mock *MockAuthenticatorInOrder) Login(ctx context.Context, user string, secret string) (string, error) {
+	_mock.seq.Record(&_mock.Mock, "Login", ctx, user, secret)
+	ret := _mock.Called(ctx, user, secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ManyArgsReturns")
+		panic("no return value specified for Login")
 	}
 
-	var r0 []string
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, int) ([]string, error)); ok {
-		return returnFunc(str, i)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, user, secret)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, int) []string); ok {
-		r0 = returnFunc(str, i)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, user, secret)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = returnFunc(str, i)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, user, secret)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockExpecter_ManyArgsReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyArgsReturns'
-type MockExpecter_ManyArgsReturns_Call struct {
+// MockAuthenticatorInOrder_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthenticatorInOrder_Login_Call struct {
 	*mock.Call
 }
 
-// ManyArgsReturns is a helper method to define mock.On call
-//   - str
-//   - i
-func (_e *MockExpecter_Expecter) ManyArgsReturns(str interface{}, i interface{}) *MockExpecter_ManyArgsReturns_Call {
-	return &MockExpecter_ManyArgsReturns_Call{Call: _e.mock.On("ManyArgsReturns", str, i)}
+// Login is a helper method to define mock.On call
+//   - ctx
+//   - user
+//   - secret
+func (_e *MockAuthenticatorInOrder_Expecter) Login(ctx interface{}, user interface{}, secret interface{}) *MockAuthenticatorInOrder_Login_Call {
+	return &MockAuthenticatorInOrder_Login_Call{Call: _e.mock.On("Login", ctx, user, secret)}
 }
 
-func (_c *MockExpecter_ManyArgsReturns_Call) Run(run func(str string, i int)) *MockExpecter_ManyArgsReturns_Call {
+func (_c *MockAuthenticatorInOrder_Login_Call) Run(run func(ctx context.Context, user string, secret string)) *MockAuthenticatorInOrder_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockExpecter_ManyArgsReturns_Call) Return(strs []string, err error) *MockExpecter_ManyArgsReturns_Call {
-	_c.Call.Return(strs, err)
+func (_c *MockAuthenticatorInOrder_Login_Call) Return(token string, err error) *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.Return(token, err)
 	return _c
 }
 
-func (_c *MockExpecter_ManyArgsReturns_Call) RunAndReturn(run func(str string, i int) ([]string, error)) *MockExpecter_ManyArgsReturns_Call {
+func (_c *MockAuthenticatorInOrder_Login_Call) RunAndReturn(run func(ctx context.Context, user string, secret string) (string, error)) *MockAuthenticatorInOrder_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NoArg provides a mock function for the type MockExpecter
-func (_mock *MockExpecter) NoArg() string {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for NoArg")
-	}
-
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func() string); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-	return r0
-}
-
-// MockExpecter_NoArg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoArg'
-type MockExpecter_NoArg_Call struct {
-	*mock.Call
-}
-
-// NoArg is a helper method to define mock.On call
-func (_e *MockExpecter_Expecter) NoArg() *MockExpecter_NoArg_Call {
-	return &MockExpecter_NoArg_Call{Call: _e.mock.On("NoArg")}
-}
-
-func (_c *MockExpecter_NoArg_Call) Run(run func()) *MockExpecter_NoArg_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+func (_c *MockAuthenticatorInOrder_Login_Call) Once() *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.Once()
 	return _c
 }
 
-func (_c *MockExpecter_NoArg_Call) Return(s string) *MockExpecter_NoArg_Call {
-	_c.Call.Return(s)
+func (_c *MockAuthenticatorInOrder_Login_Call) Twice() *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.Twice()
 	return _c
 }
 
-func (_c *MockExpecter_NoArg_Call) RunAndReturn(run func() string) *MockExpecter_NoArg_Call {
-	_c.Call.Return(run)
+func (_c *MockAuthenticatorInOrder_Login_Call) Times(i int) *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.Times(i)
 	return _c
 }
 
-// NoReturn provides a mock function for the type MockExpecter
-func (_mock *MockExpecter) NoReturn(str string) {
-	_mock.Called(str)
-	return
+func (_c *MockAuthenticatorInOrder_Login_Call) Maybe() *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// MockExpecter_NoReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoReturn'
-type MockExpecter_NoReturn_Call struct {
-	*mock.Call
+func (_c *MockAuthenticatorInOrder_Login_Call) After(d time.Duration) *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-// NoReturn is a helper method to define mock.On call
-//   - str
-func (_e *MockExpecter_Expecter) NoReturn(str interface{}) *MockExpecter_NoReturn_Call {
-	return &MockExpecter_NoReturn_Call{Call: _e.mock.On("NoReturn", str)}
+func (_c *MockAuthenticatorInOrder_Login_Call) WaitUntil(w <-chan time.Time) *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-func (_c *MockExpecter_NoReturn_Call) Run(run func(str string)) *MockExpecter_NoReturn_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
+func (_c *MockAuthenticatorInOrder_Login_Call) NotBefore(calls ...*mock.Call) *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockExpecter_NoReturn_Call) Return() *MockExpecter_NoReturn_Call {
-	_c.Call.Return()
+func (_c *MockAuthenticatorInOrder_Login_Call) Unset() *MockAuthenticatorInOrder_Login_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-func (_c *MockExpecter_NoReturn_Call) RunAndReturn(run func(str string)) *MockExpecter_NoReturn_Call {
-	_c.Run(run)
-	return _c
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockAuthenticatorInOrder_Login_Call) MockCall() *mock.Call {
+	return _c.Call
 }
 
-// Variadic provides a mock function for the type MockExpecter
-func (_mock *MockExpecter) Variadic(ints ...int) error {
-	// int
-	_va := make([]interface{}, len(ints))
-	for _i := range ints {
-		_va[_i] = ints[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _mock.Called(_ca...)
+// Logout provides a mock function for the type MockAuthenticatorInOrder
+func (_mock *MockAuthenticatorInOrder) Logout(ctx context.Context, token string) error {
+	_mock.seq.Record(&_mock.Mock, "Logout", ctx, token)
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Variadic")
+		panic("no return value specified for Logout")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(...int) error); ok {
-		r0 = returnFunc(ints...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockExpecter_Variadic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Variadic'
-type MockExpecter_Variadic_Call struct {
+// MockAuthenticatorInOrder_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthenticatorInOrder_Logout_Call struct {
 	*mock.Call
 }
 
-// Variadic is a helper method to define mock.On call
-//   - ints
-func (_e *MockExpecter_Expecter) Variadic(ints ...interface{}) *MockExpecter_Variadic_Call {
-	return &MockExpecter_Variadic_Call{Call: _e.mock.On("Variadic",
-		append([]interface{}{}, ints...)...)}
+// Logout is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *MockAuthenticatorInOrder_Expecter) Logout(ctx interface{}, token interface{}) *MockAuthenticatorInOrder_Logout_Call {
+	return &MockAuthenticatorInOrder_Logout_Call{Call: _e.mock.On("Logout", ctx, token)}
 }
 
-func (_c *MockExpecter_Variadic_Call) Run(run func(ints ...int)) *MockExpecter_Variadic_Call {
+func (_c *MockAuthenticatorInOrder_Logout_Call) Run(run func(ctx context.Context, token string)) *MockAuthenticatorInOrder_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]int, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(int)
-			}
-		}
-		run(variadicArgs...)
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExpecter_Variadic_Call) Return(err error) *MockExpecter_Variadic_Call {
+func (_c *MockAuthenticatorInOrder_Logout_Call) Return(err error) *MockAuthenticatorInOrder_Logout_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockExpecter_Variadic_Call) RunAndReturn(run func(ints ...int) error) *MockExpecter_Variadic_Call {
+func (_c *MockAuthenticatorInOrder_Logout_Call) RunAndReturn(run func(ctx context.Context, token string) error) *MockAuthenticatorInOrder_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// VariadicMany provides a mock function for the type MockExpecter
-func (_mock *MockExpecter) VariadicMany(i int, a string, intfs ...interface{}) error {
-	var _ca []interface{}
-	_ca = append(_ca, i, a)
-	_ca = append(_ca, intfs...)
-	ret := _mock.Called(_ca...)
-
-	if len(ret) == 0 {
-		panic("no return value specified for VariadicMany")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, ...interface{}) error); ok {
-		r0 = returnFunc(i, a, intfs...)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+func (_c *MockAuthenticatorInOrder_Logout_Call) Once() *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.Once()
+	return _c
 }
 
-// MockExpecter_VariadicMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VariadicMany'
-type MockExpecter_VariadicMany_Call struct {
-	*mock.Call
+func (_c *MockAuthenticatorInOrder_Logout_Call) Twice() *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// VariadicMany is a helper method to define mock.On call
-//   - i
-//   - a
-//   - intfs
-func (_e *MockExpecter_Expecter) VariadicMany(i interface{}, a interface{}, intfs ...interface{}) *MockExpecter_VariadicMany_Call {
-	return &MockExpecter_VariadicMany_Call{Call: _e.mock.On("VariadicMany",
-		append([]interface{}{i, a}, intfs...)...)}
-}
-
-func (_c *MockExpecter_VariadicMany_Call) Run(run func(i int, a string, intfs ...interface{})) *MockExpecter_VariadicMany_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]interface{}, len(args)-2)
-		for i, a := range args[2:] {
-			if a != nil {
-				variadicArgs[i] = a.(interface{})
-			}
-		}
-		run(args[0].(int), args[1].(string), variadicArgs...)
-	})
+func (_c *MockAuthenticatorInOrder_Logout_Call) Times(i int) *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.Times(i)
 	return _c
 }
 
-func (_c *MockExpecter_VariadicMany_Call) Return(err error) *MockExpecter_VariadicMany_Call {
-	_c.Call.Return(err)
+func (_c *MockAuthenticatorInOrder_Logout_Call) Maybe() *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.Maybe()
 	return _c
 }
 
-func (_c *MockExpecter_VariadicMany_Call) RunAndReturn(run func(i int, a string, intfs ...interface{}) error) *MockExpecter_VariadicMany_Call {
-	_c.Call.Return(run)
+func (_c *MockAuthenticatorInOrder_Logout_Call) After(d time.Duration) *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.After(d)
 	return _c
 }
 
-// NewMockVariadicNoReturnInterface creates a new instance of MockVariadicNoReturnInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockVariadicNoReturnInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockVariadicNoReturnInterface {
-	mock := &MockVariadicNoReturnInterface{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+func (_c *MockAuthenticatorInOrder_Logout_Call) WaitUntil(w <-chan time.Time) *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// MockVariadicNoReturnInterface is an autogenerated mock type for the VariadicNoReturnInterface type
-type MockVariadicNoReturnInterface struct {
-	mock.Mock
+func (_c *MockAuthenticatorInOrder_Logout_Call) NotBefore(calls ...*mock.Call) *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
 }
 
-type MockVariadicNoReturnInterface_Expecter struct {
-	mock *mock.Mock
+func (_c *MockAuthenticatorInOrder_Logout_Call) Unset() *MockAuthenticatorInOrder_Logout_Call {
+	_c.Call.Unset()
+	return _c
 }
 
-func (_m *MockVariadicNoReturnInterface) EXPECT() *MockVariadicNoReturnInterface_Expecter {
-	return &MockVariadicNoReturnInterface_Expecter{mock: &_m.Mock}
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockAuthenticatorInOrder_Logout_Call) MockCall() *mock.Call {
+	return _c.Call
 }
 
-// VariadicNoReturn provides a mock function for the type MockVariadicNoReturnInterface
-func (_mock *MockVariadicNoReturnInterface) VariadicNoReturn(j int, is ...interface{}) {
-	if len(is) > 0 {
-		_mock.Called(j, is)
-	} else {
-		_mock.Called(j)
-	}
+// Ping provides a mock function for the type MockAuthenticatorInOrder
+func (_mock *MockAuthenticatorInOrder) Ping(n int) {
+	_mock.seq.Record(&_mock.Mock, "Ping", n)
+	_mock.Called(n)
 	return
 }
 
-// MockVariadicNoReturnInterface_VariadicNoReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VariadicNoReturn'
-type MockVariadicNoReturnInterface_VariadicNoReturn_Call struct {
+// MockAuthenticatorInOrder_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockAuthenticatorInOrder_Ping_Call struct {
 	*mock.Call
 }
 
-// VariadicNoReturn is a helper method to define mock.On call
-//   - j
-//   - is
-func (_e *MockVariadicNoReturnInterface_Expecter) VariadicNoReturn(j interface{}, is ...interface{}) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
-	return &MockVariadicNoReturnInterface_VariadicNoReturn_Call{Call: _e.mock.On("VariadicNoReturn",
-		append([]interface{}{j}, is...)...)}
+// Ping is a helper method to define mock.On call
+//   - n
+func (_e *MockAuthenticatorInOrder_Expecter) Ping(n interface{}) *MockAuthenticatorInOrder_Ping_Call {
+	return &MockAuthenticatorInOrder_Ping_Call{Call: _e.mock.On("Ping", n)}
 }
 
-func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Run(run func(j int, is ...interface{})) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+func (_c *MockAuthenticatorInOrder_Ping_Call) Run(run func(n int)) *MockAuthenticatorInOrder_Ping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]interface{}, len(args)-1)
-		for i, a := range args[1:] {
-			if a != nil {
-				variadicArgs[i] = a.(interface{})
-			}
-		}
-		run(args[0].(int), variadicArgs...)
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Return() *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+func (_c *MockAuthenticatorInOrder_Ping_Call) Return() *MockAuthenticatorInOrder_Ping_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) RunAndReturn(run func(j int, is ...interface{})) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+func (_c *MockAuthenticatorInOrder_Ping_Call) RunAndReturn(run func(n int)) *MockAuthenticatorInOrder_Ping_Call {
 	_c.Run(run)
 	return _c
 }
 
-// NewMockFuncArgsCollision creates a new instance of MockFuncArgsCollision. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func (_c *MockAuthenticatorInOrder_Ping_Call) Once() *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) Twice() *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) Times(i int) *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) Maybe() *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) After(d time.Duration) *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) WaitUntil(w <-chan time.Time) *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) NotBefore(calls ...*mock.Call) *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockAuthenticatorInOrder_Ping_Call) Unset() *MockAuthenticatorInOrder_Ping_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockAuthenticatorInOrder_Ping_Call) MockCall() *mock.Call {
+	return _c.Call
+}
+
+// NewMockConsulLock creates a new instance of MockConsulLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockFuncArgsCollision(t interface {
+func NewMockConsulLock(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockFuncArgsCollision {
-	mock := &MockFuncArgsCollision{}
+}) *MockConsulLock {
+	mock := &MockConsulLock{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1411,277 +1755,332 @@ func NewMockFuncArgsCollision(t interface {
 	return mock
 }
 
-// MockFuncArgsCollision is an autogenerated mock type for the FuncArgsCollision type
-type MockFuncArgsCollision struct {
+// MockConsulLock is an autogenerated mock type for the ConsulLock type
+type MockConsulLock struct {
 	mock.Mock
 }
 
-type MockFuncArgsCollision_Expecter struct {
+type MockConsulLock_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockFuncArgsCollision) EXPECT() *MockFuncArgsCollision_Expecter {
-	return &MockFuncArgsCollision_Expecter{mock: &_m.Mock}
+func (_m *MockConsulLock) EXPECT() *MockConsulLock_Expecter {
+	return &MockConsulLock_Expecter{mock: &_m.Mock}
 }
 
-// Foo provides a mock function for the type MockFuncArgsCollision
-func (_mock *MockFuncArgsCollision) Foo(ret interface{}) error {
-	ret1 := _mock.Called(ret)
+// Lock provides a mock function for the type MockConsulLock
+func (_mock *MockConsulLock) Lock(valCh <-chan struct{}) (<-chan struct{}, error) {
+	ret := _mock.Called(valCh)
 
-	if len(ret1) == 0 {
-		panic("no return value specified for Foo")
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret1.Get(0).(func(interface{}) error); ok {
-		r0 = returnFunc(ret)
+	var r0 <-chan struct{}
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(<-chan struct{}) (<-chan struct{}, error)); ok {
+		return returnFunc(valCh)
+	}
+	if returnFunc, ok := ret.Get(0).(func(<-chan struct{}) <-chan struct{}); ok {
+		r0 = returnFunc(valCh)
 	} else {
-		r0 = ret1.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(<-chan struct{}) error); ok {
+		r1 = returnFunc(valCh)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockFuncArgsCollision_Foo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Foo'
-type MockFuncArgsCollision_Foo_Call struct {
+// MockConsulLock_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type MockConsulLock_Lock_Call struct {
 	*mock.Call
 }
 
-// Foo is a helper method to define mock.On call
-//   - ret
-func (_e *MockFuncArgsCollision_Expecter) Foo(ret interface{}) *MockFuncArgsCollision_Foo_Call {
-	return &MockFuncArgsCollision_Foo_Call{Call: _e.mock.On("Foo", ret)}
+// Lock is a helper method to define mock.On call
+//   - valCh
+func (_e *MockConsulLock_Expecter) Lock(valCh interface{}) *MockConsulLock_Lock_Call {
+	return &MockConsulLock_Lock_Call{Call: _e.mock.On("Lock", valCh)}
 }
 
-func (_c *MockFuncArgsCollision_Foo_Call) Run(run func(ret interface{})) *MockFuncArgsCollision_Foo_Call {
+func (_c *MockConsulLock_Lock_Call) Run(run func(valCh <-chan struct{})) *MockConsulLock_Lock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(interface{}))
+		run(args[0].(<-chan struct{}))
 	})
 	return _c
 }
 
-func (_c *MockFuncArgsCollision_Foo_Call) Return(err error) *MockFuncArgsCollision_Foo_Call {
-	_c.Call.Return(err)
+func (_c *MockConsulLock_Lock_Call) Return(valCh1 <-chan struct{}, err error) *MockConsulLock_Lock_Call {
+	_c.Call.Return(valCh1, err)
 	return _c
 }
 
-func (_c *MockFuncArgsCollision_Foo_Call) RunAndReturn(run func(ret interface{}) error) *MockFuncArgsCollision_Foo_Call {
+func (_c *MockConsulLock_Lock_Call) RunAndReturn(run func(valCh <-chan struct{}) (<-chan struct{}, error)) *MockConsulLock_Lock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterGenerics creates a new instance of MockRequesterGenerics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterGenerics[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
-	~int | GenericType[int, GetInt]
-	comparable
-}](t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	mock := &MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
-	mock.Mock.Test(t)
+func (_c *MockConsulLock_Lock_Call) Once() *MockConsulLock_Lock_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockConsulLock_Lock_Call) Twice() *MockConsulLock_Lock_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockConsulLock_Lock_Call) Times(i int) *MockConsulLock_Lock_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterGenerics is an autogenerated mock type for the RequesterGenerics type
-type MockRequesterGenerics[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
-	~int | GenericType[int, GetInt]
-	comparable
-}] struct {
-	mock.Mock
+func (_c *MockConsulLock_Lock_Call) Maybe() *MockConsulLock_Lock_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockRequesterGenerics_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
-	~int | GenericType[int, GetInt]
-	comparable
-}] struct {
-	mock *mock.Mock
+func (_c *MockConsulLock_Lock_Call) After(d time.Duration) *MockConsulLock_Lock_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	return &MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
+func (_c *MockConsulLock_Lock_Call) WaitUntil(w <-chan time.Time) *MockConsulLock_Lock_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// GenericAnonymousStructs provides a mock function for the type MockRequesterGenerics
-func (_mock *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
-	Type2 GenericType[string, EmbeddedGet[int]]
-} {
-	ret := _mock.Called(val)
+func (_c *MockConsulLock_Lock_Call) NotBefore(calls ...*mock.Call) *MockConsulLock_Lock_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockConsulLock_Lock_Call) Unset() *MockConsulLock_Lock_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Unlock provides a mock function for the type MockConsulLock
+func (_mock *MockConsulLock) Unlock() error {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenericAnonymousStructs")
+		panic("no return value specified for Unlock")
 	}
 
-	var r0 struct {
-		Type2 GenericType[string, EmbeddedGet[int]]
-	}
-	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
-		Type2 GenericType[string, EmbeddedGet[int]]
-	}); ok {
-		r0 = returnFunc(val)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(struct {
-			Type2 GenericType[string, EmbeddedGet[int]]
-		})
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRequesterGenerics_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
-type MockRequesterGenerics_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
-	~int | GenericType[int, GetInt]
-	comparable
-}] struct {
+// MockConsulLock_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type MockConsulLock_Unlock_Call struct {
 	*mock.Call
 }
 
-// GenericAnonymousStructs is a helper method to define mock.On call
-//   - val
-func (_e *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val interface{}) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	return &MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val)}
+// Unlock is a helper method to define mock.On call
+func (_e *MockConsulLock_Expecter) Unlock() *MockConsulLock_Unlock_Call {
+	return &MockConsulLock_Unlock_Call{Call: _e.mock.On("Unlock")}
 }
 
-func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+func (_c *MockConsulLock_Unlock_Call) Run(run func()) *MockConsulLock_Unlock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(struct{ Type1 TExternalIntf }))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
-	Type2 GenericType[string, EmbeddedGet[int]]
-}) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	_c.Call.Return(val1)
+func (_c *MockConsulLock_Unlock_Call) Return(err error) *MockConsulLock_Unlock_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
-	Type2 GenericType[string, EmbeddedGet[int]]
-}) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+func (_c *MockConsulLock_Unlock_Call) RunAndReturn(run func() error) *MockConsulLock_Unlock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenericArguments provides a mock function for the type MockRequesterGenerics
-func (_mock *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
-	ret := _mock.Called(v, v1)
+func (_c *MockConsulLock_Unlock_Call) Once() *MockConsulLock_Unlock_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) Twice() *MockConsulLock_Unlock_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) Times(i int) *MockConsulLock_Unlock_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) Maybe() *MockConsulLock_Unlock_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) After(d time.Duration) *MockConsulLock_Unlock_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) WaitUntil(w <-chan time.Time) *MockConsulLock_Unlock_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) NotBefore(calls ...*mock.Call) *MockConsulLock_Unlock_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockConsulLock_Unlock_Call) Unset() *MockConsulLock_Unlock_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockKeyManager creates a new instance of MockKeyManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockKeyManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockKeyManager {
+	mock := &MockKeyManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockKeyManager is an autogenerated mock type for the KeyManager type
+type MockKeyManager struct {
+	mock.Mock
+}
+
+type MockKeyManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockKeyManager) EXPECT() *MockKeyManager_Expecter {
+	return &MockKeyManager_Expecter{mock: &_m.Mock}
+}
+
+// GetKey provides a mock function for the type MockKeyManager
+func (_mock *MockKeyManager) GetKey(s string, v uint16) ([]byte, *Err) {
+	ret := _mock.Called(s, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenericArguments")
+		panic("no return value specified for GetKey")
 	}
 
-	var r0 TSigned
-	var r1 TIntf
-	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
-		return returnFunc(v, v1)
+	var r0 []byte
+	var r1 *Err
+	if returnFunc, ok := ret.Get(0).(func(string, uint16) ([]byte, *Err)); ok {
+		return returnFunc(s, v)
 	}
-	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
-		r0 = returnFunc(v, v1)
+	if returnFunc, ok := ret.Get(0).(func(string, uint16) []byte); ok {
+		r0 = returnFunc(s, v)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(TSigned)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
-		r1 = returnFunc(v, v1)
+	if returnFunc, ok := ret.Get(1).(func(string, uint16) *Err); ok {
+		r1 = returnFunc(s, v)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(TIntf)
+			r1 = ret.Get(1).(*Err)
 		}
 	}
 	return r0, r1
 }
 
-// MockRequesterGenerics_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
-type MockRequesterGenerics_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
-	~int | GenericType[int, GetInt]
-	comparable
-}] struct {
+// MockKeyManager_GetKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKey'
+type MockKeyManager_GetKey_Call struct {
 	*mock.Call
 }
 
-// GenericArguments is a helper method to define mock.On call
+// GetKey is a helper method to define mock.On call
+//   - s
 //   - v
-//   - v1
-func (_e *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v interface{}, v1 interface{}) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	return &MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v, v1)}
+func (_e *MockKeyManager_Expecter) GetKey(s interface{}, v interface{}) *MockKeyManager_GetKey_Call {
+	return &MockKeyManager_GetKey_Call{Call: _e.mock.On("GetKey", s, v)}
 }
 
-func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+func (_c *MockKeyManager_GetKey_Call) Run(run func(s string, v uint16)) *MockKeyManager_GetKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(TAny), args[1].(TComparable))
+		run(args[0].(string), args[1].(uint16))
 	})
 	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	_c.Call.Return(v2, v3)
+func (_c *MockKeyManager_GetKey_Call) Return(bytes []byte, err *Err) *MockKeyManager_GetKey_Call {
+	_c.Call.Return(bytes, err)
 	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+func (_c *MockKeyManager_GetKey_Call) RunAndReturn(run func(s string, v uint16) ([]byte, *Err)) *MockKeyManager_GetKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenericStructs provides a mock function for the type MockRequesterGenerics
-func (_mock *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
-	ret := _mock.Called(genericType)
+func (_c *MockKeyManager_GetKey_Call) Once() *MockKeyManager_GetKey_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for GenericStructs")
-	}
+func (_c *MockKeyManager_GetKey_Call) Twice() *MockKeyManager_GetKey_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	var r0 GenericType[TSigned, TIntf]
-	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
-		r0 = returnFunc(genericType)
-	} else {
-		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
-	}
-	return r0
+func (_c *MockKeyManager_GetKey_Call) Times(i int) *MockKeyManager_GetKey_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterGenerics_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
-type MockRequesterGenerics_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
-	~int | GenericType[int, GetInt]
-	comparable
-}] struct {
-	*mock.Call
+func (_c *MockKeyManager_GetKey_Call) Maybe() *MockKeyManager_GetKey_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// GenericStructs is a helper method to define mock.On call
-//   - genericType
-func (_e *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType interface{}) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	return &MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType)}
+func (_c *MockKeyManager_GetKey_Call) After(d time.Duration) *MockKeyManager_GetKey_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(GenericType[TAny, TIntf]))
-	})
+func (_c *MockKeyManager_GetKey_Call) WaitUntil(w <-chan time.Time) *MockKeyManager_GetKey_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	_c.Call.Return(genericType1)
+func (_c *MockKeyManager_GetKey_Call) NotBefore(calls ...*mock.Call) *MockKeyManager_GetKey_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
-	_c.Call.Return(run)
+func (_c *MockKeyManager_GetKey_Call) Unset() *MockKeyManager_GetKey_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockGetInt creates a new instance of MockGetInt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockBlank creates a new instance of MockBlank. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGetInt(t interface {
+func NewMockBlank(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGetInt {
-	mock := &MockGetInt{}
+}) *MockBlank {
+	mock := &MockBlank{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1689,70 +2088,111 @@ func NewMockGetInt(t interface {
 	return mock
 }
 
-// MockGetInt is an autogenerated mock type for the GetInt type
-type MockGetInt struct {
+// MockBlank is an autogenerated mock type for the Blank type
+type MockBlank struct {
 	mock.Mock
 }
 
-type MockGetInt_Expecter struct {
+type MockBlank_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGetInt) EXPECT() *MockGetInt_Expecter {
-	return &MockGetInt_Expecter{mock: &_m.Mock}
+func (_m *MockBlank) EXPECT() *MockBlank_Expecter {
+	return &MockBlank_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockGetInt
-func (_mock *MockGetInt) Get() int {
-	ret := _mock.Called()
+// Create provides a mock function for the type MockBlank
+func (_mock *MockBlank) Create(x interface{}) error {
+	ret := _mock.Called(x)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for Create")
 	}
 
-	var r0 int
-	if returnFunc, ok := ret.Get(0).(func() int); ok {
-		r0 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = returnFunc(x)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockGetInt_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockGetInt_Get_Call struct {
+// MockBlank_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockBlank_Create_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-func (_e *MockGetInt_Expecter) Get() *MockGetInt_Get_Call {
-	return &MockGetInt_Get_Call{Call: _e.mock.On("Get")}
+// Create is a helper method to define mock.On call
+//   - x
+func (_e *MockBlank_Expecter) Create(x interface{}) *MockBlank_Create_Call {
+	return &MockBlank_Create_Call{Call: _e.mock.On("Create", x)}
 }
 
-func (_c *MockGetInt_Get_Call) Run(run func()) *MockGetInt_Get_Call {
+func (_c *MockBlank_Create_Call) Run(run func(x interface{})) *MockBlank_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockGetInt_Get_Call) Return(n int) *MockGetInt_Get_Call {
-	_c.Call.Return(n)
+func (_c *MockBlank_Create_Call) Return(err error) *MockBlank_Create_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGetInt_Get_Call) RunAndReturn(run func() int) *MockGetInt_Get_Call {
+func (_c *MockBlank_Create_Call) RunAndReturn(run func(x interface{}) error) *MockBlank_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockGetGeneric creates a new instance of MockGetGeneric. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func (_c *MockBlank_Create_Call) Once() *MockBlank_Create_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) Twice() *MockBlank_Create_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) Times(i int) *MockBlank_Create_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) Maybe() *MockBlank_Create_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) After(d time.Duration) *MockBlank_Create_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) WaitUntil(w <-chan time.Time) *MockBlank_Create_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) NotBefore(calls ...*mock.Call) *MockBlank_Create_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockBlank_Create_Call) Unset() *MockBlank_Create_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockExpecterAndRolledVariadic creates a new instance of MockExpecterAndRolledVariadic. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGetGeneric[T constraints.Integer](t interface {
+func NewMockExpecterAndRolledVariadic(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGetGeneric[T] {
-	mock := &MockGetGeneric[T]{}
+}) *MockExpecterAndRolledVariadic {
+	mock := &MockExpecterAndRolledVariadic{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1760,384 +2200,479 @@ func NewMockGetGeneric[T constraints.Integer](t interface {
 	return mock
 }
 
-// MockGetGeneric is an autogenerated mock type for the GetGeneric type
-type MockGetGeneric[T constraints.Integer] struct {
+// MockExpecterAndRolledVariadic is an autogenerated mock type for the Expecter type
+type MockExpecterAndRolledVariadic struct {
 	mock.Mock
 }
 
-type MockGetGeneric_Expecter[T constraints.Integer] struct {
+type MockExpecterAndRolledVariadic_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGetGeneric[T]) EXPECT() *MockGetGeneric_Expecter[T] {
-	return &MockGetGeneric_Expecter[T]{mock: &_m.Mock}
+func (_m *MockExpecterAndRolledVariadic) EXPECT() *MockExpecterAndRolledVariadic_Expecter {
+	return &MockExpecterAndRolledVariadic_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockGetGeneric
-func (_mock *MockGetGeneric[T]) Get() T {
-	ret := _mock.Called()
+// ManyArgsReturns provides a mock function for the type MockExpecterAndRolledVariadic
+func (_mock *MockExpecterAndRolledVariadic) ManyArgsReturns(str string, i int) ([]string, error) {
+	ret := _mock.Called(str, i)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for ManyArgsReturns")
 	}
 
-	var r0 T
-	if returnFunc, ok := ret.Get(0).(func() T); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(T)
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int) ([]string, error)); ok {
+		return returnFunc(str, i)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int) []string); ok {
+		r0 = returnFunc(str, i)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = returnFunc(str, i)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockGetGeneric_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockGetGeneric_Get_Call[T constraints.Integer] struct {
+// MockExpecterAndRolledVariadic_ManyArgsReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyArgsReturns'
+type MockExpecterAndRolledVariadic_ManyArgsReturns_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-func (_e *MockGetGeneric_Expecter[T]) Get() *MockGetGeneric_Get_Call[T] {
-	return &MockGetGeneric_Get_Call[T]{Call: _e.mock.On("Get")}
+// ManyArgsReturns is a helper method to define mock.On call
+//   - str
+//   - i
+func (_e *MockExpecterAndRolledVariadic_Expecter) ManyArgsReturns(str interface{}, i interface{}) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	return &MockExpecterAndRolledVariadic_ManyArgsReturns_Call{Call: _e.mock.On("ManyArgsReturns", str, i)}
 }
 
-func (_c *MockGetGeneric_Get_Call[T]) Run(run func()) *MockGetGeneric_Get_Call[T] {
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Run(run func(str string, i int)) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *MockGetGeneric_Get_Call[T]) Return(v T) *MockGetGeneric_Get_Call[T] {
-	_c.Call.Return(v)
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Return(strs []string, err error) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.Return(strs, err)
 	return _c
 }
 
-func (_c *MockGetGeneric_Get_Call[T]) RunAndReturn(run func() T) *MockGetGeneric_Get_Call[T] {
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) RunAndReturn(run func(str string, i int) ([]string, error)) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockEmbeddedGet creates a new instance of MockEmbeddedGet. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockEmbeddedGet[T constraints.Signed](t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockEmbeddedGet[T] {
-	mock := &MockEmbeddedGet[T]{}
-	mock.Mock.Test(t)
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Once() *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Twice() *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Times(i int) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockEmbeddedGet is an autogenerated mock type for the EmbeddedGet type
-type MockEmbeddedGet[T constraints.Signed] struct {
-	mock.Mock
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Maybe() *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockEmbeddedGet_Expecter[T constraints.Signed] struct {
-	mock *mock.Mock
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) After(d time.Duration) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockEmbeddedGet[T]) EXPECT() *MockEmbeddedGet_Expecter[T] {
-	return &MockEmbeddedGet_Expecter[T]{mock: &_m.Mock}
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) WaitUntil(w <-chan time.Time) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Get provides a mock function for the type MockEmbeddedGet
-func (_mock *MockEmbeddedGet[T]) Get() T {
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) NotBefore(calls ...*mock.Call) *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_ManyArgsReturns_Call) Unset() *MockExpecterAndRolledVariadic_ManyArgsReturns_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NoArg provides a mock function for the type MockExpecterAndRolledVariadic
+func (_mock *MockExpecterAndRolledVariadic) NoArg() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for NoArg")
 	}
 
-	var r0 T
-	if returnFunc, ok := ret.Get(0).(func() T); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
 		r0 = returnFunc()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(T)
-		}
+		r0 = ret.Get(0).(string)
 	}
 	return r0
 }
 
-// MockEmbeddedGet_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockEmbeddedGet_Get_Call[T constraints.Signed] struct {
+// MockExpecterAndRolledVariadic_NoArg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoArg'
+type MockExpecterAndRolledVariadic_NoArg_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-func (_e *MockEmbeddedGet_Expecter[T]) Get() *MockEmbeddedGet_Get_Call[T] {
-	return &MockEmbeddedGet_Get_Call[T]{Call: _e.mock.On("Get")}
+// NoArg is a helper method to define mock.On call
+func (_e *MockExpecterAndRolledVariadic_Expecter) NoArg() *MockExpecterAndRolledVariadic_NoArg_Call {
+	return &MockExpecterAndRolledVariadic_NoArg_Call{Call: _e.mock.On("NoArg")}
 }
 
-func (_c *MockEmbeddedGet_Get_Call[T]) Run(run func()) *MockEmbeddedGet_Get_Call[T] {
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Run(run func()) *MockExpecterAndRolledVariadic_NoArg_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockEmbeddedGet_Get_Call[T]) Return(v T) *MockEmbeddedGet_Get_Call[T] {
-	_c.Call.Return(v)
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Return(s string) *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockEmbeddedGet_Get_Call[T]) RunAndReturn(run func() T) *MockEmbeddedGet_Get_Call[T] {
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) RunAndReturn(run func() string) *MockExpecterAndRolledVariadic_NoArg_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReplaceGeneric creates a new instance of MockReplaceGeneric. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockReplaceGeneric[TImport any, TConstraint constraints.Signed, TKeep any](t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockReplaceGeneric[TImport, TConstraint, TKeep] {
-	mock := &MockReplaceGeneric[TImport, TConstraint, TKeep]{}
-	mock.Mock.Test(t)
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Once() *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Twice() *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Times(i int) *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockReplaceGeneric is an autogenerated mock type for the ReplaceGeneric type
-type MockReplaceGeneric[TImport any, TConstraint constraints.Signed, TKeep any] struct {
-	mock.Mock
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Maybe() *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockReplaceGeneric_Expecter[TImport any, TConstraint constraints.Signed, TKeep any] struct {
-	mock *mock.Mock
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) After(d time.Duration) *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockReplaceGeneric[TImport, TConstraint, TKeep]) EXPECT() *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep] {
-	return &MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]{mock: &_m.Mock}
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) WaitUntil(w <-chan time.Time) *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// A provides a mock function for the type MockReplaceGeneric
-func (_mock *MockReplaceGeneric[TImport, TConstraint, TKeep]) A(t1 TImport) TKeep {
-	ret := _mock.Called(t1)
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) NotBefore(calls ...*mock.Call) *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for A")
-	}
+func (_c *MockExpecterAndRolledVariadic_NoArg_Call) Unset() *MockExpecterAndRolledVariadic_NoArg_Call {
+	_c.Call.Unset()
+	return _c
+}
 
-	var r0 TKeep
-	if returnFunc, ok := ret.Get(0).(func(TImport) TKeep); ok {
-		r0 = returnFunc(t1)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(TKeep)
-		}
-	}
-	return r0
+// NoReturn provides a mock function for the type MockExpecterAndRolledVariadic
+func (_mock *MockExpecterAndRolledVariadic) NoReturn(str string) {
+	_mock.Called(str)
+	return
 }
 
-// MockReplaceGeneric_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
-type MockReplaceGeneric_A_Call[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+// MockExpecterAndRolledVariadic_NoReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoReturn'
+type MockExpecterAndRolledVariadic_NoReturn_Call struct {
 	*mock.Call
 }
 
-// A is a helper method to define mock.On call
-//   - t1
-func (_e *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]) A(t1 interface{}) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
-	return &MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]{Call: _e.mock.On("A", t1)}
+// NoReturn is a helper method to define mock.On call
+//   - str
+func (_e *MockExpecterAndRolledVariadic_Expecter) NoReturn(str interface{}) *MockExpecterAndRolledVariadic_NoReturn_Call {
+	return &MockExpecterAndRolledVariadic_NoReturn_Call{Call: _e.mock.On("NoReturn", str)}
 }
 
-func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Run(run func(t1 TImport)) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Run(run func(str string)) *MockExpecterAndRolledVariadic_NoReturn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(TImport))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Return(v TKeep) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
-	_c.Call.Return(v)
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Return() *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) RunAndReturn(run func(t1 TImport) TKeep) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
-	_c.Call.Return(run)
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) RunAndReturn(run func(str string)) *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Run(run)
 	return _c
 }
 
-// B provides a mock function for the type MockReplaceGeneric
-func (_mock *MockReplaceGeneric[TImport, TConstraint, TKeep]) B() TImport {
-	ret := _mock.Called()
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Once() *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for B")
-	}
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Twice() *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	var r0 TImport
-	if returnFunc, ok := ret.Get(0).(func() TImport); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(TImport)
-		}
-	}
-	return r0
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Times(i int) *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockReplaceGeneric_B_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'B'
-type MockReplaceGeneric_B_Call[TImport any, TConstraint constraints.Signed, TKeep any] struct {
-	*mock.Call
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Maybe() *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// B is a helper method to define mock.On call
-func (_e *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]) B() *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
-	return &MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]{Call: _e.mock.On("B")}
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) After(d time.Duration) *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Run(run func()) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) WaitUntil(w <-chan time.Time) *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Return(v TImport) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
-	_c.Call.Return(v)
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) NotBefore(calls ...*mock.Call) *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) RunAndReturn(run func() TImport) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
-	_c.Call.Return(run)
+func (_c *MockExpecterAndRolledVariadic_NoReturn_Call) Unset() *MockExpecterAndRolledVariadic_NoReturn_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// C provides a mock function for the type MockReplaceGeneric
-func (_mock *MockReplaceGeneric[TImport, TConstraint, TKeep]) C() TConstraint {
-	ret := _mock.Called()
+// Variadic provides a mock function for the type MockExpecterAndRolledVariadic
+func (_mock *MockExpecterAndRolledVariadic) Variadic(ints ...int) error {
+	var tmpRet mock.Arguments
+	if len(ints) > 0 {
+		tmpRet = _mock.Called(ints)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for C")
+		panic("no return value specified for Variadic")
 	}
 
-	var r0 TConstraint
-	if returnFunc, ok := ret.Get(0).(func() TConstraint); ok {
-		r0 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(...int) error); ok {
+		r0 = returnFunc(ints...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(TConstraint)
-		}
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockReplaceGeneric_C_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'C'
-type MockReplaceGeneric_C_Call[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+// MockExpecterAndRolledVariadic_Variadic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Variadic'
+type MockExpecterAndRolledVariadic_Variadic_Call struct {
 	*mock.Call
 }
 
-// C is a helper method to define mock.On call
-func (_e *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]) C() *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
-	return &MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]{Call: _e.mock.On("C")}
+// Variadic is a helper method to define mock.On call
+//   - ints
+func (_e *MockExpecterAndRolledVariadic_Expecter) Variadic(ints ...interface{}) *MockExpecterAndRolledVariadic_Variadic_Call {
+	return &MockExpecterAndRolledVariadic_Variadic_Call{Call: _e.mock.On("Variadic",
+		append([]interface{}{}, ints...)...)}
 }
 
-func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Run(run func()) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Run(run func(ints ...int)) *MockExpecterAndRolledVariadic_Variadic_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]int, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(int)
+			}
+		}
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Return(v TConstraint) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
-	_c.Call.Return(v)
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Return(err error) *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) RunAndReturn(run func() TConstraint) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) RunAndReturn(run func(ints ...int) error) *MockExpecterAndRolledVariadic_Variadic_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReplaceGenericSelf creates a new instance of MockReplaceGenericSelf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockReplaceGenericSelf[T any](t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockReplaceGenericSelf[T] {
-	mock := &MockReplaceGenericSelf[T]{}
-	mock.Mock.Test(t)
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Once() *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Twice() *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Times(i int) *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockReplaceGenericSelf is an autogenerated mock type for the ReplaceGenericSelf type
-type MockReplaceGenericSelf[T any] struct {
-	mock.Mock
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Maybe() *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockReplaceGenericSelf_Expecter[T any] struct {
-	mock *mock.Mock
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) After(d time.Duration) *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockReplaceGenericSelf[T]) EXPECT() *MockReplaceGenericSelf_Expecter[T] {
-	return &MockReplaceGenericSelf_Expecter[T]{mock: &_m.Mock}
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) WaitUntil(w <-chan time.Time) *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// A provides a mock function for the type MockReplaceGenericSelf
-func (_mock *MockReplaceGenericSelf[T]) A() T {
-	ret := _mock.Called()
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) NotBefore(calls ...*mock.Call) *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_Variadic_Call) Unset() *MockExpecterAndRolledVariadic_Variadic_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// VariadicMany provides a mock function for the type MockExpecterAndRolledVariadic
+func (_mock *MockExpecterAndRolledVariadic) VariadicMany(i int, a string, intfs ...interface{}) error {
+	var tmpRet mock.Arguments
+	if len(intfs) > 0 {
+		tmpRet = _mock.Called(i, a, intfs)
+	} else {
+		tmpRet = _mock.Called(i, a)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for A")
+		panic("no return value specified for VariadicMany")
 	}
 
-	var r0 T
-	if returnFunc, ok := ret.Get(0).(func() T); ok {
-		r0 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, ...interface{}) error); ok {
+		r0 = returnFunc(i, a, intfs...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(T)
-		}
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockReplaceGenericSelf_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
-type MockReplaceGenericSelf_A_Call[T any] struct {
+// MockExpecterAndRolledVariadic_VariadicMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VariadicMany'
+type MockExpecterAndRolledVariadic_VariadicMany_Call struct {
 	*mock.Call
 }
 
-// A is a helper method to define mock.On call
-func (_e *MockReplaceGenericSelf_Expecter[T]) A() *MockReplaceGenericSelf_A_Call[T] {
-	return &MockReplaceGenericSelf_A_Call[T]{Call: _e.mock.On("A")}
+// VariadicMany is a helper method to define mock.On call
+//   - i
+//   - a
+//   - intfs
+func (_e *MockExpecterAndRolledVariadic_Expecter) VariadicMany(i interface{}, a interface{}, intfs ...interface{}) *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	return &MockExpecterAndRolledVariadic_VariadicMany_Call{Call: _e.mock.On("VariadicMany",
+		append([]interface{}{i, a}, intfs...)...)}
 }
 
-func (_c *MockReplaceGenericSelf_A_Call[T]) Run(run func()) *MockReplaceGenericSelf_A_Call[T] {
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Run(run func(i int, a string, intfs ...interface{})) *MockExpecterAndRolledVariadic_VariadicMany_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(int), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockReplaceGenericSelf_A_Call[T]) Return(v T) *MockReplaceGenericSelf_A_Call[T] {
-	_c.Call.Return(v)
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Return(err error) *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReplaceGenericSelf_A_Call[T]) RunAndReturn(run func() T) *MockReplaceGenericSelf_A_Call[T] {
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) RunAndReturn(run func(i int, a string, intfs ...interface{}) error) *MockExpecterAndRolledVariadic_VariadicMany_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockHasConflictingNestedImports creates a new instance of MockHasConflictingNestedImports. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Once() *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Twice() *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Times(i int) *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Maybe() *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) After(d time.Duration) *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) WaitUntil(w <-chan time.Time) *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) NotBefore(calls ...*mock.Call) *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExpecterAndRolledVariadic_VariadicMany_Call) Unset() *MockExpecterAndRolledVariadic_VariadicMany_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockExpecter creates a new instance of MockExpecter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockHasConflictingNestedImports(t interface {
+func NewMockExpecter(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockHasConflictingNestedImports {
-	mock := &MockHasConflictingNestedImports{}
+}) *MockExpecter {
+	mock := &MockExpecter{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2145,500 +2680,477 @@ func NewMockHasConflictingNestedImports(t interface {
 	return mock
 }
 
-// MockHasConflictingNestedImports is an autogenerated mock type for the HasConflictingNestedImports type
-type MockHasConflictingNestedImports struct {
+// MockExpecter is an autogenerated mock type for the Expecter type
+type MockExpecter struct {
 	mock.Mock
 }
 
-type MockHasConflictingNestedImports_Expecter struct {
+type MockExpecter_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockHasConflictingNestedImports) EXPECT() *MockHasConflictingNestedImports_Expecter {
-	return &MockHasConflictingNestedImports_Expecter{mock: &_m.Mock}
+func (_m *MockExpecter) EXPECT() *MockExpecter_Expecter {
+	return &MockExpecter_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockHasConflictingNestedImports
-func (_mock *MockHasConflictingNestedImports) Get(path string) (http.Response, error) {
-	ret := _mock.Called(path)
+// ManyArgsReturns provides a mock function for the type MockExpecter
+func (_mock *MockExpecter) ManyArgsReturns(str string, i int) ([]string, error) {
+	ret := _mock.Called(str, i)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for ManyArgsReturns")
 	}
 
-	var r0 http.Response
+	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (http.Response, error)); ok {
-		return returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(string, int) ([]string, error)); ok {
+		return returnFunc(str, i)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) http.Response); ok {
-		r0 = returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(string, int) []string); ok {
+		r0 = returnFunc(str, i)
 	} else {
-		r0 = ret.Get(0).(http.Response)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = returnFunc(str, i)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockHasConflictingNestedImports_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockHasConflictingNestedImports_Get_Call struct {
+// MockExpecter_ManyArgsReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManyArgsReturns'
+type MockExpecter_ManyArgsReturns_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockHasConflictingNestedImports_Expecter) Get(path interface{}) *MockHasConflictingNestedImports_Get_Call {
-	return &MockHasConflictingNestedImports_Get_Call{Call: _e.mock.On("Get", path)}
+// ManyArgsReturns is a helper method to define mock.On call
+//   - str
+//   - i
+func (_e *MockExpecter_Expecter) ManyArgsReturns(str interface{}, i interface{}) *MockExpecter_ManyArgsReturns_Call {
+	return &MockExpecter_ManyArgsReturns_Call{Call: _e.mock.On("ManyArgsReturns", str, i)}
 }
 
-func (_c *MockHasConflictingNestedImports_Get_Call) Run(run func(path string)) *MockHasConflictingNestedImports_Get_Call {
+func (_c *MockExpecter_ManyArgsReturns_Call) Run(run func(str string, i int)) *MockExpecter_ManyArgsReturns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *MockHasConflictingNestedImports_Get_Call) Return(response http.Response, err error) *MockHasConflictingNestedImports_Get_Call {
-	_c.Call.Return(response, err)
+func (_c *MockExpecter_ManyArgsReturns_Call) Return(strs []string, err error) *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.Return(strs, err)
 	return _c
 }
 
-func (_c *MockHasConflictingNestedImports_Get_Call) RunAndReturn(run func(path string) (http.Response, error)) *MockHasConflictingNestedImports_Get_Call {
+func (_c *MockExpecter_ManyArgsReturns_Call) RunAndReturn(run func(str string, i int) ([]string, error)) *MockExpecter_ManyArgsReturns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Z provides a mock function for the type MockHasConflictingNestedImports
-func (_mock *MockHasConflictingNestedImports) Z() http0.MyStruct {
+func (_c *MockExpecter_ManyArgsReturns_Call) Once() *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) Twice() *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) Times(i int) *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) Maybe() *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) After(d time.Duration) *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) WaitUntil(w <-chan time.Time) *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) NotBefore(calls ...*mock.Call) *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExpecter_ManyArgsReturns_Call) Unset() *MockExpecter_ManyArgsReturns_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NoArg provides a mock function for the type MockExpecter
+func (_mock *MockExpecter) NoArg() string {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Z")
+		panic("no return value specified for NoArg")
 	}
 
-	var r0 http0.MyStruct
-	if returnFunc, ok := ret.Get(0).(func() http0.MyStruct); ok {
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(http0.MyStruct)
+		r0 = ret.Get(0).(string)
 	}
 	return r0
 }
 
-// MockHasConflictingNestedImports_Z_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Z'
-type MockHasConflictingNestedImports_Z_Call struct {
+// MockExpecter_NoArg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoArg'
+type MockExpecter_NoArg_Call struct {
 	*mock.Call
 }
 
-// Z is a helper method to define mock.On call
-func (_e *MockHasConflictingNestedImports_Expecter) Z() *MockHasConflictingNestedImports_Z_Call {
-	return &MockHasConflictingNestedImports_Z_Call{Call: _e.mock.On("Z")}
+// NoArg is a helper method to define mock.On call
+func (_e *MockExpecter_Expecter) NoArg() *MockExpecter_NoArg_Call {
+	return &MockExpecter_NoArg_Call{Call: _e.mock.On("NoArg")}
 }
 
-func (_c *MockHasConflictingNestedImports_Z_Call) Run(run func()) *MockHasConflictingNestedImports_Z_Call {
+func (_c *MockExpecter_NoArg_Call) Run(run func()) *MockExpecter_NoArg_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockHasConflictingNestedImports_Z_Call) Return(myStruct http0.MyStruct) *MockHasConflictingNestedImports_Z_Call {
-	_c.Call.Return(myStruct)
+func (_c *MockExpecter_NoArg_Call) Return(s string) *MockExpecter_NoArg_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockHasConflictingNestedImports_Z_Call) RunAndReturn(run func() http0.MyStruct) *MockHasConflictingNestedImports_Z_Call {
+func (_c *MockExpecter_NoArg_Call) RunAndReturn(run func() string) *MockExpecter_NoArg_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockImportsSameAsPackage creates a new instance of MockImportsSameAsPackage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockImportsSameAsPackage(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockImportsSameAsPackage {
-	mock := &MockImportsSameAsPackage{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+func (_c *MockExpecter_NoArg_Call) Once() *MockExpecter_NoArg_Call {
+	_c.Call.Once()
+	return _c
 }
 
-// MockImportsSameAsPackage is an autogenerated mock type for the ImportsSameAsPackage type
-type MockImportsSameAsPackage struct {
-	mock.Mock
+func (_c *MockExpecter_NoArg_Call) Twice() *MockExpecter_NoArg_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-type MockImportsSameAsPackage_Expecter struct {
-	mock *mock.Mock
+func (_c *MockExpecter_NoArg_Call) Times(i int) *MockExpecter_NoArg_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-func (_m *MockImportsSameAsPackage) EXPECT() *MockImportsSameAsPackage_Expecter {
-	return &MockImportsSameAsPackage_Expecter{mock: &_m.Mock}
-}
-
-// A provides a mock function for the type MockImportsSameAsPackage
-func (_mock *MockImportsSameAsPackage) A() test.B {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for A")
-	}
-
-	var r0 test.B
-	if returnFunc, ok := ret.Get(0).(func() test.B); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Get(0).(test.B)
-	}
-	return r0
-}
-
-// MockImportsSameAsPackage_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
-type MockImportsSameAsPackage_A_Call struct {
-	*mock.Call
+func (_c *MockExpecter_NoArg_Call) Maybe() *MockExpecter_NoArg_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// A is a helper method to define mock.On call
-func (_e *MockImportsSameAsPackage_Expecter) A() *MockImportsSameAsPackage_A_Call {
-	return &MockImportsSameAsPackage_A_Call{Call: _e.mock.On("A")}
+func (_c *MockExpecter_NoArg_Call) After(d time.Duration) *MockExpecter_NoArg_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockImportsSameAsPackage_A_Call) Run(run func()) *MockImportsSameAsPackage_A_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+func (_c *MockExpecter_NoArg_Call) WaitUntil(w <-chan time.Time) *MockExpecter_NoArg_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockImportsSameAsPackage_A_Call) Return(b test.B) *MockImportsSameAsPackage_A_Call {
-	_c.Call.Return(b)
+func (_c *MockExpecter_NoArg_Call) NotBefore(calls ...*mock.Call) *MockExpecter_NoArg_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockImportsSameAsPackage_A_Call) RunAndReturn(run func() test.B) *MockImportsSameAsPackage_A_Call {
-	_c.Call.Return(run)
+func (_c *MockExpecter_NoArg_Call) Unset() *MockExpecter_NoArg_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// B provides a mock function for the type MockImportsSameAsPackage
-func (_mock *MockImportsSameAsPackage) B() KeyManager {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for B")
-	}
-
-	var r0 KeyManager
-	if returnFunc, ok := ret.Get(0).(func() KeyManager); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(KeyManager)
-		}
-	}
-	return r0
+// NoReturn provides a mock function for the type MockExpecter
+func (_mock *MockExpecter) NoReturn(str string) {
+	_mock.Called(str)
+	return
 }
 
-// MockImportsSameAsPackage_B_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'B'
-type MockImportsSameAsPackage_B_Call struct {
+// MockExpecter_NoReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoReturn'
+type MockExpecter_NoReturn_Call struct {
 	*mock.Call
 }
 
-// B is a helper method to define mock.On call
-func (_e *MockImportsSameAsPackage_Expecter) B() *MockImportsSameAsPackage_B_Call {
-	return &MockImportsSameAsPackage_B_Call{Call: _e.mock.On("B")}
+// NoReturn is a helper method to define mock.On call
+//   - str
+func (_e *MockExpecter_Expecter) NoReturn(str interface{}) *MockExpecter_NoReturn_Call {
+	return &MockExpecter_NoReturn_Call{Call: _e.mock.On("NoReturn", str)}
 }
 
-func (_c *MockImportsSameAsPackage_B_Call) Run(run func()) *MockImportsSameAsPackage_B_Call {
+func (_c *MockExpecter_NoReturn_Call) Run(run func(str string)) *MockExpecter_NoReturn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockImportsSameAsPackage_B_Call) Return(keyManager KeyManager) *MockImportsSameAsPackage_B_Call {
-	_c.Call.Return(keyManager)
+func (_c *MockExpecter_NoReturn_Call) Return() *MockExpecter_NoReturn_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockImportsSameAsPackage_B_Call) RunAndReturn(run func() KeyManager) *MockImportsSameAsPackage_B_Call {
-	_c.Call.Return(run)
+func (_c *MockExpecter_NoReturn_Call) RunAndReturn(run func(str string)) *MockExpecter_NoReturn_Call {
+	_c.Run(run)
 	return _c
 }
 
-// C provides a mock function for the type MockImportsSameAsPackage
-func (_mock *MockImportsSameAsPackage) C(c C) {
-	_mock.Called(c)
-	return
-}
-
-// MockImportsSameAsPackage_C_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'C'
-type MockImportsSameAsPackage_C_Call struct {
-	*mock.Call
-}
-
-// C is a helper method to define mock.On call
-//   - c
-func (_e *MockImportsSameAsPackage_Expecter) C(c interface{}) *MockImportsSameAsPackage_C_Call {
-	return &MockImportsSameAsPackage_C_Call{Call: _e.mock.On("C", c)}
+func (_c *MockExpecter_NoReturn_Call) Once() *MockExpecter_NoReturn_Call {
+	_c.Call.Once()
+	return _c
 }
 
-func (_c *MockImportsSameAsPackage_C_Call) Run(run func(c C)) *MockImportsSameAsPackage_C_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(C))
-	})
+func (_c *MockExpecter_NoReturn_Call) Twice() *MockExpecter_NoReturn_Call {
+	_c.Call.Twice()
 	return _c
 }
 
-func (_c *MockImportsSameAsPackage_C_Call) Return() *MockImportsSameAsPackage_C_Call {
-	_c.Call.Return()
+func (_c *MockExpecter_NoReturn_Call) Times(i int) *MockExpecter_NoReturn_Call {
+	_c.Call.Times(i)
 	return _c
 }
 
-func (_c *MockImportsSameAsPackage_C_Call) RunAndReturn(run func(c C)) *MockImportsSameAsPackage_C_Call {
-	_c.Run(run)
+func (_c *MockExpecter_NoReturn_Call) Maybe() *MockExpecter_NoReturn_Call {
+	_c.Call.Maybe()
 	return _c
 }
 
-// NewMockGenericInterface creates a new instance of MockGenericInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockGenericInterface[M any](t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockGenericInterface[M] {
-	mock := &MockGenericInterface[M]{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+func (_c *MockExpecter_NoReturn_Call) After(d time.Duration) *MockExpecter_NoReturn_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-// MockGenericInterface is an autogenerated mock type for the GenericInterface type
-type MockGenericInterface[M any] struct {
-	mock.Mock
+func (_c *MockExpecter_NoReturn_Call) WaitUntil(w <-chan time.Time) *MockExpecter_NoReturn_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-type MockGenericInterface_Expecter[M any] struct {
-	mock *mock.Mock
+func (_c *MockExpecter_NoReturn_Call) NotBefore(calls ...*mock.Call) *MockExpecter_NoReturn_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
 }
 
-func (_m *MockGenericInterface[M]) EXPECT() *MockGenericInterface_Expecter[M] {
-	return &MockGenericInterface_Expecter[M]{mock: &_m.Mock}
+func (_c *MockExpecter_NoReturn_Call) Unset() *MockExpecter_NoReturn_Call {
+	_c.Call.Unset()
+	return _c
 }
 
-// Func provides a mock function for the type MockGenericInterface
-func (_mock *MockGenericInterface[M]) Func(arg *M) int {
-	ret := _mock.Called(arg)
+// Variadic provides a mock function for the type MockExpecter
+func (_mock *MockExpecter) Variadic(ints ...int) error {
+	// int
+	_va := make([]interface{}, len(ints))
+	for _i := range ints {
+		_va[_i] = ints[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Func")
+		panic("no return value specified for Variadic")
 	}
 
-	var r0 int
-	if returnFunc, ok := ret.Get(0).(func(*M) int); ok {
-		r0 = returnFunc(arg)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(...int) error); ok {
+		r0 = returnFunc(ints...)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockGenericInterface_Func_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Func'
-type MockGenericInterface_Func_Call[M any] struct {
+// MockExpecter_Variadic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Variadic'
+type MockExpecter_Variadic_Call struct {
 	*mock.Call
 }
 
-// Func is a helper method to define mock.On call
-//   - arg
-func (_e *MockGenericInterface_Expecter[M]) Func(arg interface{}) *MockGenericInterface_Func_Call[M] {
-	return &MockGenericInterface_Func_Call[M]{Call: _e.mock.On("Func", arg)}
+// Variadic is a helper method to define mock.On call
+//   - ints
+func (_e *MockExpecter_Expecter) Variadic(ints ...interface{}) *MockExpecter_Variadic_Call {
+	return &MockExpecter_Variadic_Call{Call: _e.mock.On("Variadic",
+		append([]interface{}{}, ints...)...)}
 }
 
-func (_c *MockGenericInterface_Func_Call[M]) Run(run func(arg *M)) *MockGenericInterface_Func_Call[M] {
+func (_c *MockExpecter_Variadic_Call) Run(run func(ints ...int)) *MockExpecter_Variadic_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*M))
+		variadicArgs := make([]int, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(int)
+			}
+		}
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGenericInterface_Func_Call[M]) Return(n int) *MockGenericInterface_Func_Call[M] {
-	_c.Call.Return(n)
+func (_c *MockExpecter_Variadic_Call) Return(err error) *MockExpecter_Variadic_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGenericInterface_Func_Call[M]) RunAndReturn(run func(arg *M) int) *MockGenericInterface_Func_Call[M] {
+func (_c *MockExpecter_Variadic_Call) RunAndReturn(run func(ints ...int) error) *MockExpecter_Variadic_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockInstantiatedGenericInterface creates a new instance of MockInstantiatedGenericInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockInstantiatedGenericInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockInstantiatedGenericInterface {
-	mock := &MockInstantiatedGenericInterface{}
-	mock.Mock.Test(t)
+func (_c *MockExpecter_Variadic_Call) Once() *MockExpecter_Variadic_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockExpecter_Variadic_Call) Twice() *MockExpecter_Variadic_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockExpecter_Variadic_Call) Times(i int) *MockExpecter_Variadic_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockInstantiatedGenericInterface is an autogenerated mock type for the InstantiatedGenericInterface type
-type MockInstantiatedGenericInterface struct {
-	mock.Mock
+func (_c *MockExpecter_Variadic_Call) Maybe() *MockExpecter_Variadic_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockInstantiatedGenericInterface_Expecter struct {
-	mock *mock.Mock
+func (_c *MockExpecter_Variadic_Call) After(d time.Duration) *MockExpecter_Variadic_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockInstantiatedGenericInterface) EXPECT() *MockInstantiatedGenericInterface_Expecter {
-	return &MockInstantiatedGenericInterface_Expecter{mock: &_m.Mock}
+func (_c *MockExpecter_Variadic_Call) WaitUntil(w <-chan time.Time) *MockExpecter_Variadic_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Func provides a mock function for the type MockInstantiatedGenericInterface
-func (_mock *MockInstantiatedGenericInterface) Func(arg *float32) int {
-	ret := _mock.Called(arg)
+func (_c *MockExpecter_Variadic_Call) NotBefore(calls ...*mock.Call) *MockExpecter_Variadic_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExpecter_Variadic_Call) Unset() *MockExpecter_Variadic_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// VariadicMany provides a mock function for the type MockExpecter
+func (_mock *MockExpecter) VariadicMany(i int, a string, intfs ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, i, a)
+	_ca = append(_ca, intfs...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Func")
+		panic("no return value specified for VariadicMany")
 	}
 
-	var r0 int
-	if returnFunc, ok := ret.Get(0).(func(*float32) int); ok {
-		r0 = returnFunc(arg)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, ...interface{}) error); ok {
+		r0 = returnFunc(i, a, intfs...)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockInstantiatedGenericInterface_Func_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Func'
-type MockInstantiatedGenericInterface_Func_Call struct {
+// MockExpecter_VariadicMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VariadicMany'
+type MockExpecter_VariadicMany_Call struct {
 	*mock.Call
 }
 
-// Func is a helper method to define mock.On call
-//   - arg
-func (_e *MockInstantiatedGenericInterface_Expecter) Func(arg interface{}) *MockInstantiatedGenericInterface_Func_Call {
-	return &MockInstantiatedGenericInterface_Func_Call{Call: _e.mock.On("Func", arg)}
+// VariadicMany is a helper method to define mock.On call
+//   - i
+//   - a
+//   - intfs
+func (_e *MockExpecter_Expecter) VariadicMany(i interface{}, a interface{}, intfs ...interface{}) *MockExpecter_VariadicMany_Call {
+	return &MockExpecter_VariadicMany_Call{Call: _e.mock.On("VariadicMany",
+		append([]interface{}{i, a}, intfs...)...)}
 }
 
-func (_c *MockInstantiatedGenericInterface_Func_Call) Run(run func(arg *float32)) *MockInstantiatedGenericInterface_Func_Call {
+func (_c *MockExpecter_VariadicMany_Call) Run(run func(i int, a string, intfs ...interface{})) *MockExpecter_VariadicMany_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*float32))
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(int), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockInstantiatedGenericInterface_Func_Call) Return(n int) *MockInstantiatedGenericInterface_Func_Call {
-	_c.Call.Return(n)
+func (_c *MockExpecter_VariadicMany_Call) Return(err error) *MockExpecter_VariadicMany_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockInstantiatedGenericInterface_Func_Call) RunAndReturn(run func(arg *float32) int) *MockInstantiatedGenericInterface_Func_Call {
+func (_c *MockExpecter_VariadicMany_Call) RunAndReturn(run func(i int, a string, intfs ...interface{}) error) *MockExpecter_VariadicMany_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockMyReader creates a new instance of MockMyReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockMyReader(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockMyReader {
-	mock := &MockMyReader{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockMyReader is an autogenerated mock type for the MyReader type
-type MockMyReader struct {
-	mock.Mock
-}
-
-type MockMyReader_Expecter struct {
-	mock *mock.Mock
+func (_c *MockExpecter_VariadicMany_Call) Once() *MockExpecter_VariadicMany_Call {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *MockMyReader) EXPECT() *MockMyReader_Expecter {
-	return &MockMyReader_Expecter{mock: &_m.Mock}
+func (_c *MockExpecter_VariadicMany_Call) Twice() *MockExpecter_VariadicMany_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// Read provides a mock function for the type MockMyReader
-func (_mock *MockMyReader) Read(p []byte) (int, error) {
-	ret := _mock.Called(p)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Read")
-	}
-
-	var r0 int
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func([]byte) (int, error)); ok {
-		return returnFunc(p)
-	}
-	if returnFunc, ok := ret.Get(0).(func([]byte) int); ok {
-		r0 = returnFunc(p)
-	} else {
-		r0 = ret.Get(0).(int)
-	}
-	if returnFunc, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = returnFunc(p)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+func (_c *MockExpecter_VariadicMany_Call) Times(i int) *MockExpecter_VariadicMany_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockMyReader_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
-type MockMyReader_Read_Call struct {
-	*mock.Call
+func (_c *MockExpecter_VariadicMany_Call) Maybe() *MockExpecter_VariadicMany_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Read is a helper method to define mock.On call
-//   - p
-func (_e *MockMyReader_Expecter) Read(p interface{}) *MockMyReader_Read_Call {
-	return &MockMyReader_Read_Call{Call: _e.mock.On("Read", p)}
+func (_c *MockExpecter_VariadicMany_Call) After(d time.Duration) *MockExpecter_VariadicMany_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockMyReader_Read_Call) Run(run func(p []byte)) *MockMyReader_Read_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]byte))
-	})
+func (_c *MockExpecter_VariadicMany_Call) WaitUntil(w <-chan time.Time) *MockExpecter_VariadicMany_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockMyReader_Read_Call) Return(n int, err error) *MockMyReader_Read_Call {
-	_c.Call.Return(n, err)
+func (_c *MockExpecter_VariadicMany_Call) NotBefore(calls ...*mock.Call) *MockExpecter_VariadicMany_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockMyReader_Read_Call) RunAndReturn(run func(p []byte) (int, error)) *MockMyReader_Read_Call {
-	_c.Call.Return(run)
+func (_c *MockExpecter_VariadicMany_Call) Unset() *MockExpecter_VariadicMany_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockIssue766 creates a new instance of MockIssue766. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockVariadicNoReturnInterface creates a new instance of MockVariadicNoReturnInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockIssue766(t interface {
+func NewMockVariadicNoReturnInterface(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockIssue766 {
-	mock := &MockIssue766{}
+}) *MockVariadicNoReturnInterface {
+	mock := &MockVariadicNoReturnInterface{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2646,154 +3158,113 @@ func NewMockIssue766(t interface {
 	return mock
 }
 
-// MockIssue766 is an autogenerated mock type for the Issue766 type
-type MockIssue766 struct {
+// MockVariadicNoReturnInterface is an autogenerated mock type for the VariadicNoReturnInterface type
+type MockVariadicNoReturnInterface struct {
 	mock.Mock
 }
 
-type MockIssue766_Expecter struct {
+type MockVariadicNoReturnInterface_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockIssue766) EXPECT() *MockIssue766_Expecter {
-	return &MockIssue766_Expecter{mock: &_m.Mock}
+func (_m *MockVariadicNoReturnInterface) EXPECT() *MockVariadicNoReturnInterface_Expecter {
+	return &MockVariadicNoReturnInterface_Expecter{mock: &_m.Mock}
 }
 
-// FetchData provides a mock function for the type MockIssue766
-func (_mock *MockIssue766) FetchData(fetchFunc func(x ...int) ([]int, error)) ([]int, error) {
-	ret := _mock.Called(fetchFunc)
-
-	if len(ret) == 0 {
-		panic("no return value specified for FetchData")
-	}
-
-	var r0 []int
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(func(x ...int) ([]int, error)) ([]int, error)); ok {
-		return returnFunc(fetchFunc)
-	}
-	if returnFunc, ok := ret.Get(0).(func(func(x ...int) ([]int, error)) []int); ok {
-		r0 = returnFunc(fetchFunc)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]int)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(func(x ...int) ([]int, error)) error); ok {
-		r1 = returnFunc(fetchFunc)
+// VariadicNoReturn provides a mock function for the type MockVariadicNoReturnInterface
+func (_mock *MockVariadicNoReturnInterface) VariadicNoReturn(j int, is ...interface{}) {
+	if len(is) > 0 {
+		_mock.Called(j, is)
 	} else {
-		r1 = ret.Error(1)
+		_mock.Called(j)
 	}
-	return r0, r1
+
+	return
 }
 
-// MockIssue766_FetchData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchData'
-type MockIssue766_FetchData_Call struct {
+// MockVariadicNoReturnInterface_VariadicNoReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VariadicNoReturn'
+type MockVariadicNoReturnInterface_VariadicNoReturn_Call struct {
 	*mock.Call
 }
 
-// FetchData is a helper method to define mock.On call
-//   - fetchFunc
-func (_e *MockIssue766_Expecter) FetchData(fetchFunc interface{}) *MockIssue766_FetchData_Call {
-	return &MockIssue766_FetchData_Call{Call: _e.mock.On("FetchData", fetchFunc)}
+// VariadicNoReturn is a helper method to define mock.On call
+//   - j
+//   - is
+func (_e *MockVariadicNoReturnInterface_Expecter) VariadicNoReturn(j interface{}, is ...interface{}) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	return &MockVariadicNoReturnInterface_VariadicNoReturn_Call{Call: _e.mock.On("VariadicNoReturn",
+		append([]interface{}{j}, is...)...)}
 }
 
-func (_c *MockIssue766_FetchData_Call) Run(run func(fetchFunc func(x ...int) ([]int, error))) *MockIssue766_FetchData_Call {
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Run(run func(j int, is ...interface{})) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(func(x ...int) ([]int, error)))
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(int), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockIssue766_FetchData_Call) Return(ints []int, err error) *MockIssue766_FetchData_Call {
-	_c.Call.Return(ints, err)
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Return() *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockIssue766_FetchData_Call) RunAndReturn(run func(fetchFunc func(x ...int) ([]int, error)) ([]int, error)) *MockIssue766_FetchData_Call {
-	_c.Call.Return(run)
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) RunAndReturn(run func(j int, is ...interface{})) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Run(run)
 	return _c
 }
 
-// NewMockMapToInterface creates a new instance of MockMapToInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockMapToInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockMapToInterface {
-	mock := &MockMapToInterface{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockMapToInterface is an autogenerated mock type for the MapToInterface type
-type MockMapToInterface struct {
-	mock.Mock
-}
-
-type MockMapToInterface_Expecter struct {
-	mock *mock.Mock
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Once() *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *MockMapToInterface) EXPECT() *MockMapToInterface_Expecter {
-	return &MockMapToInterface_Expecter{mock: &_m.Mock}
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Twice() *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// Foo provides a mock function for the type MockMapToInterface
-func (_mock *MockMapToInterface) Foo(arg1 ...map[string]interface{}) {
-	if len(arg1) > 0 {
-		_mock.Called(arg1)
-	} else {
-		_mock.Called()
-	}
-	return
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Times(i int) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockMapToInterface_Foo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Foo'
-type MockMapToInterface_Foo_Call struct {
-	*mock.Call
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Maybe() *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Foo is a helper method to define mock.On call
-//   - arg1
-func (_e *MockMapToInterface_Expecter) Foo(arg1 ...interface{}) *MockMapToInterface_Foo_Call {
-	return &MockMapToInterface_Foo_Call{Call: _e.mock.On("Foo",
-		append([]interface{}{}, arg1...)...)}
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) After(d time.Duration) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockMapToInterface_Foo_Call) Run(run func(arg1 ...map[string]interface{})) *MockMapToInterface_Foo_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]map[string]interface{}, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(map[string]interface{})
-			}
-		}
-		run(variadicArgs...)
-	})
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) WaitUntil(w <-chan time.Time) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockMapToInterface_Foo_Call) Return() *MockMapToInterface_Foo_Call {
-	_c.Call.Return()
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) NotBefore(calls ...*mock.Call) *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockMapToInterface_Foo_Call) RunAndReturn(run func(arg1 ...map[string]interface{})) *MockMapToInterface_Foo_Call {
-	_c.Run(run)
+func (_c *MockVariadicNoReturnInterface_VariadicNoReturn_Call) Unset() *MockVariadicNoReturnInterface_VariadicNoReturn_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockSibling creates a new instance of MockSibling. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockFuncArgsCollision creates a new instance of MockFuncArgsCollision. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSibling(t interface {
+func NewMockFuncArgsCollision(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSibling {
-	mock := &MockSibling{}
+}) *MockFuncArgsCollision {
+	mock := &MockFuncArgsCollision{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2801,120 +3272,114 @@ func NewMockSibling(t interface {
 	return mock
 }
 
-// MockSibling is an autogenerated mock type for the Sibling type
-type MockSibling struct {
+// MockFuncArgsCollision is an autogenerated mock type for the FuncArgsCollision type
+type MockFuncArgsCollision struct {
 	mock.Mock
 }
 
-type MockSibling_Expecter struct {
+type MockFuncArgsCollision_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSibling) EXPECT() *MockSibling_Expecter {
-	return &MockSibling_Expecter{mock: &_m.Mock}
+func (_m *MockFuncArgsCollision) EXPECT() *MockFuncArgsCollision_Expecter {
+	return &MockFuncArgsCollision_Expecter{mock: &_m.Mock}
 }
 
-// DoSomething provides a mock function for the type MockSibling
-func (_mock *MockSibling) DoSomething() {
-	_mock.Called()
-	return
+// Foo provides a mock function for the type MockFuncArgsCollision
+func (_mock *MockFuncArgsCollision) Foo(ret interface{}) error {
+	ret1 := _mock.Called(ret)
+
+	if len(ret1) == 0 {
+		panic("no return value specified for Foo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret1.Get(0).(func(interface{}) error); ok {
+		r0 = returnFunc(ret)
+	} else {
+		r0 = ret1.Error(0)
+	}
+	return r0
 }
 
-// MockSibling_DoSomething_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoSomething'
-type MockSibling_DoSomething_Call struct {
+// MockFuncArgsCollision_Foo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Foo'
+type MockFuncArgsCollision_Foo_Call struct {
 	*mock.Call
 }
 
-// DoSomething is a helper method to define mock.On call
-func (_e *MockSibling_Expecter) DoSomething() *MockSibling_DoSomething_Call {
-	return &MockSibling_DoSomething_Call{Call: _e.mock.On("DoSomething")}
-}
+// Foo is a helper method to define mock.On call
+//   - ret
+func (_e *MockFuncArgsCollision_Expecter) Foo(ret interface{}) *MockFuncArgsCollision_Foo_Call {
+	return &MockFuncArgsCollision_Foo_Call{Call: _e.mock.On("Foo", ret)}
+}
 
-func (_c *MockSibling_DoSomething_Call) Run(run func()) *MockSibling_DoSomething_Call {
+func (_c *MockFuncArgsCollision_Foo_Call) Run(run func(ret interface{})) *MockFuncArgsCollision_Foo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockSibling_DoSomething_Call) Return() *MockSibling_DoSomething_Call {
-	_c.Call.Return()
+func (_c *MockFuncArgsCollision_Foo_Call) Return(err error) *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSibling_DoSomething_Call) RunAndReturn(run func()) *MockSibling_DoSomething_Call {
-	_c.Run(run)
+func (_c *MockFuncArgsCollision_Foo_Call) RunAndReturn(run func(ret interface{}) error) *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUsesOtherPkgIface creates a new instance of MockUsesOtherPkgIface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUsesOtherPkgIface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUsesOtherPkgIface {
-	mock := &MockUsesOtherPkgIface{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockUsesOtherPkgIface is an autogenerated mock type for the UsesOtherPkgIface type
-type MockUsesOtherPkgIface struct {
-	mock.Mock
-}
-
-type MockUsesOtherPkgIface_Expecter struct {
-	mock *mock.Mock
+func (_c *MockFuncArgsCollision_Foo_Call) Once() *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *MockUsesOtherPkgIface) EXPECT() *MockUsesOtherPkgIface_Expecter {
-	return &MockUsesOtherPkgIface_Expecter{mock: &_m.Mock}
+func (_c *MockFuncArgsCollision_Foo_Call) Twice() *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// DoSomethingElse provides a mock function for the type MockUsesOtherPkgIface
-func (_mock *MockUsesOtherPkgIface) DoSomethingElse(obj Sibling) {
-	_mock.Called(obj)
-	return
+func (_c *MockFuncArgsCollision_Foo_Call) Times(i int) *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockUsesOtherPkgIface_DoSomethingElse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoSomethingElse'
-type MockUsesOtherPkgIface_DoSomethingElse_Call struct {
-	*mock.Call
+func (_c *MockFuncArgsCollision_Foo_Call) Maybe() *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// DoSomethingElse is a helper method to define mock.On call
-//   - obj
-func (_e *MockUsesOtherPkgIface_Expecter) DoSomethingElse(obj interface{}) *MockUsesOtherPkgIface_DoSomethingElse_Call {
-	return &MockUsesOtherPkgIface_DoSomethingElse_Call{Call: _e.mock.On("DoSomethingElse", obj)}
+func (_c *MockFuncArgsCollision_Foo_Call) After(d time.Duration) *MockFuncArgsCollision_Foo_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Run(run func(obj Sibling)) *MockUsesOtherPkgIface_DoSomethingElse_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(Sibling))
-	})
+func (_c *MockFuncArgsCollision_Foo_Call) WaitUntil(w <-chan time.Time) *MockFuncArgsCollision_Foo_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Return() *MockUsesOtherPkgIface_DoSomethingElse_Call {
-	_c.Call.Return()
+func (_c *MockFuncArgsCollision_Foo_Call) NotBefore(calls ...*mock.Call) *MockFuncArgsCollision_Foo_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) RunAndReturn(run func(obj Sibling)) *MockUsesOtherPkgIface_DoSomethingElse_Call {
-	_c.Run(run)
+func (_c *MockFuncArgsCollision_Foo_Call) Unset() *MockFuncArgsCollision_Foo_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockPanicOnNoReturnValue creates a new instance of MockPanicOnNoReturnValue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRequesterGenerics creates a new instance of MockRequesterGenerics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockPanicOnNoReturnValue(t interface {
+func NewMockRequesterGenerics[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockPanicOnNoReturnValue {
-	mock := &MockPanicOnNoReturnValue{}
+}) *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := &MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2922,294 +3387,325 @@ func NewMockPanicOnNoReturnValue(t interface {
 	return mock
 }
 
-// MockPanicOnNoReturnValue is an autogenerated mock type for the PanicOnNoReturnValue type
-type MockPanicOnNoReturnValue struct {
+// MockRequesterGenerics is an autogenerated mock type for the RequesterGenerics type
+type MockRequesterGenerics[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock.Mock
 }
 
-type MockPanicOnNoReturnValue_Expecter struct {
+type MockRequesterGenerics_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock *mock.Mock
 }
 
-func (_m *MockPanicOnNoReturnValue) EXPECT() *MockPanicOnNoReturnValue_Expecter {
-	return &MockPanicOnNoReturnValue_Expecter{mock: &_m.Mock}
+func (_m *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
 }
 
-// DoSomething provides a mock function for the type MockPanicOnNoReturnValue
-func (_mock *MockPanicOnNoReturnValue) DoSomething() string {
-	ret := _mock.Called()
+// GenericAnonymousStructs provides a mock function for the type MockRequesterGenerics
+func (_mock *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+} {
+	ret := _mock.Called(val)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DoSomething")
+		panic("no return value specified for GenericAnonymousStructs")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func() string); ok {
-		r0 = returnFunc()
+	var r0 struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}
+	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}); ok {
+		r0 = returnFunc(val)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(struct {
+			Type2 GenericType[string, EmbeddedGet[int]]
+		})
 	}
 	return r0
 }
 
-// MockPanicOnNoReturnValue_DoSomething_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoSomething'
-type MockPanicOnNoReturnValue_DoSomething_Call struct {
+// MockRequesterGenerics_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
+type MockRequesterGenerics_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// DoSomething is a helper method to define mock.On call
-func (_e *MockPanicOnNoReturnValue_Expecter) DoSomething() *MockPanicOnNoReturnValue_DoSomething_Call {
-	return &MockPanicOnNoReturnValue_DoSomething_Call{Call: _e.mock.On("DoSomething")}
+// GenericAnonymousStructs is a helper method to define mock.On call
+//   - val
+func (_e *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val interface{}) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val)}
 }
 
-func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Run(run func()) *MockPanicOnNoReturnValue_DoSomething_Call {
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(struct{ Type1 TExternalIntf }))
 	})
 	return _c
 }
 
-func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Return(s string) *MockPanicOnNoReturnValue_DoSomething_Call {
-	_c.Call.Return(s)
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(val1)
 	return _c
 }
 
-func (_c *MockPanicOnNoReturnValue_DoSomething_Call) RunAndReturn(run func() string) *MockPanicOnNoReturnValue_DoSomething_Call {
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequester creates a new instance of MockRequester. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequester(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequester {
-	mock := &MockRequester{}
-	mock.Mock.Test(t)
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequester is an autogenerated mock type for the Requester type
-type MockRequester struct {
-	mock.Mock
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockRequester_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockRequester) EXPECT() *MockRequester_Expecter {
-	return &MockRequester_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Get provides a mock function for the type MockRequester
-func (_mock *MockRequester) Get(path string) (string, error) {
-	ret := _mock.Called(path)
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenerics_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericArguments provides a mock function for the type MockRequesterGenerics
+func (_mock *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
+	ret := _mock.Called(v, v1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericArguments")
 	}
 
-	var r0 string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return returnFunc(path)
+	var r0 TSigned
+	var r1 TIntf
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
+		return returnFunc(v, v1)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
+		r0 = returnFunc(v, v1)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TSigned)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
+		r1 = returnFunc(v, v1)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(TIntf)
+		}
 	}
 	return r0, r1
 }
 
-// MockRequester_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequester_Get_Call struct {
+// MockRequesterGenerics_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
+type MockRequesterGenerics_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequester_Expecter) Get(path interface{}) *MockRequester_Get_Call {
-	return &MockRequester_Get_Call{Call: _e.mock.On("Get", path)}
+// GenericArguments is a helper method to define mock.On call
+//   - v
+//   - v1
+func (_e *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v interface{}, v1 interface{}) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v, v1)}
 }
 
-func (_c *MockRequester_Get_Call) Run(run func(path string)) *MockRequester_Get_Call {
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(TAny), args[1].(TComparable))
 	})
 	return _c
 }
 
-func (_c *MockRequester_Get_Call) Return(s string, err error) *MockRequester_Get_Call {
-	_c.Call.Return(s, err)
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(v2, v3)
 	return _c
 }
 
-func (_c *MockRequester_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequester_Get_Call {
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequester2 creates a new instance of MockRequester2. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequester2(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequester2 {
-	mock := &MockRequester2{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
 
-	return mock
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
 }
 
-// MockRequester2 is an autogenerated mock type for the Requester2 type
-type MockRequester2 struct {
-	mock.Mock
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-type MockRequester2_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-func (_m *MockRequester2) EXPECT() *MockRequester2_Expecter {
-	return &MockRequester2_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-// Get provides a mock function for the type MockRequester2
-func (_mock *MockRequester2) Get(path string) error {
-	ret := _mock.Called(path)
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenerics_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericStructs provides a mock function for the type MockRequesterGenerics
+func (_mock *MockRequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
+	ret := _mock.Called(genericType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericStructs")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(path)
+	var r0 GenericType[TSigned, TIntf]
+	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
+		r0 = returnFunc(genericType)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
 	}
 	return r0
 }
 
-// MockRequester2_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequester2_Get_Call struct {
+// MockRequesterGenerics_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
+type MockRequesterGenerics_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequester2_Expecter) Get(path interface{}) *MockRequester2_Get_Call {
-	return &MockRequester2_Get_Call{Call: _e.mock.On("Get", path)}
+// GenericStructs is a helper method to define mock.On call
+//   - genericType
+func (_e *MockRequesterGenerics_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType interface{}) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType)}
 }
 
-func (_c *MockRequester2_Get_Call) Run(run func(path string)) *MockRequester2_Get_Call {
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(GenericType[TAny, TIntf]))
 	})
 	return _c
 }
 
-func (_c *MockRequester2_Get_Call) Return(err error) *MockRequester2_Get_Call {
-	_c.Call.Return(err)
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(genericType1)
 	return _c
 }
 
-func (_c *MockRequester2_Get_Call) RunAndReturn(run func(path string) error) *MockRequester2_Get_Call {
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequester3 creates a new instance of MockRequester3. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequester3(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequester3 {
-	mock := &MockRequester3{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockRequester3 is an autogenerated mock type for the Requester3 type
-type MockRequester3 struct {
-	mock.Mock
-}
-
-type MockRequester3_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *MockRequester3) EXPECT() *MockRequester3_Expecter {
-	return &MockRequester3_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
 }
 
-// Get provides a mock function for the type MockRequester3
-func (_mock *MockRequester3) Get() error {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequester3_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequester3_Get_Call struct {
-	*mock.Call
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Get is a helper method to define mock.On call
-func (_e *MockRequester3_Expecter) Get() *MockRequester3_Get_Call {
-	return &MockRequester3_Get_Call{Call: _e.mock.On("Get")}
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockRequester3_Get_Call) Run(run func()) *MockRequester3_Get_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockRequester3_Get_Call) Return(err error) *MockRequester3_Get_Call {
-	_c.Call.Return(err)
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockRequester3_Get_Call) RunAndReturn(run func() error) *MockRequester3_Get_Call {
-	_c.Call.Return(run)
+func (_c *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenerics_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockRequester4 creates a new instance of MockRequester4. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRequesterGenericsTypedArgs creates a new instance of MockRequesterGenericsTypedArgs. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRequester4(t interface {
+func NewMockRequesterGenericsTypedArgs[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRequester4 {
-	mock := &MockRequester4{}
+}) *MockRequesterGenericsTypedArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := &MockRequesterGenericsTypedArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -3217,268 +3713,325 @@ func NewMockRequester4(t interface {
 	return mock
 }
 
-// MockRequester4 is an autogenerated mock type for the Requester4 type
-type MockRequester4 struct {
+// MockRequesterGenericsTypedArgs is an autogenerated mock type for the RequesterGenerics type
+type MockRequesterGenericsTypedArgs[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock.Mock
 }
 
-type MockRequester4_Expecter struct {
+type MockRequesterGenericsTypedArgs_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRequester4) EXPECT() *MockRequester4_Expecter {
-	return &MockRequester4_Expecter{mock: &_m.Mock}
+func (_m *MockRequesterGenericsTypedArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenericsTypedArgs_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedArgs_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockRequester4
-func (_mock *MockRequester4) Get() {
-	_mock.Called()
-	return
+// GenericAnonymousStructs provides a mock function for the type MockRequesterGenericsTypedArgs
+func (_mock *MockRequesterGenericsTypedArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+} {
+	ret := _mock.Called(val)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericAnonymousStructs")
+	}
+
+	var r0 struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}
+	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}); ok {
+		r0 = returnFunc(val)
+	} else {
+		r0 = ret.Get(0).(struct {
+			Type2 GenericType[string, EmbeddedGet[int]]
+		})
+	}
+	return r0
 }
 
-// MockRequester4_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequester4_Get_Call struct {
+// MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
+type MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-func (_e *MockRequester4_Expecter) Get() *MockRequester4_Get_Call {
-	return &MockRequester4_Get_Call{Call: _e.mock.On("Get")}
+// GenericAnonymousStructs is a helper method to define mock.On call
+//   - val
+func (_e *MockRequesterGenericsTypedArgs_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val arg.Arg[struct{ Type1 TExternalIntf }]) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val.Matcher())}
 }
 
-func (_c *MockRequester4_Get_Call) Run(run func()) *MockRequester4_Get_Call {
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(struct{ Type1 TExternalIntf }))
 	})
 	return _c
 }
 
-func (_c *MockRequester4_Get_Call) Return() *MockRequester4_Get_Call {
-	_c.Call.Return()
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(val1)
 	return _c
 }
 
-func (_c *MockRequester4_Get_Call) RunAndReturn(run func()) *MockRequester4_Get_Call {
-	_c.Run(run)
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterArgSameAsImport creates a new instance of MockRequesterArgSameAsImport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterArgSameAsImport(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterArgSameAsImport {
-	mock := &MockRequesterArgSameAsImport{}
-	mock.Mock.Test(t)
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterArgSameAsImport is an autogenerated mock type for the RequesterArgSameAsImport type
-type MockRequesterArgSameAsImport struct {
-	mock.Mock
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockRequesterArgSameAsImport_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockRequesterArgSameAsImport) EXPECT() *MockRequesterArgSameAsImport_Expecter {
-	return &MockRequesterArgSameAsImport_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterArgSameAsImport
-func (_mock *MockRequesterArgSameAsImport) Get(json1 string) *json.RawMessage {
-	ret := _mock.Called(json1)
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsTypedArgs_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericArguments provides a mock function for the type MockRequesterGenericsTypedArgs
+func (_mock *MockRequesterGenericsTypedArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
+	ret := _mock.Called(v, v1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericArguments")
 	}
 
-	var r0 *json.RawMessage
-	if returnFunc, ok := ret.Get(0).(func(string) *json.RawMessage); ok {
-		r0 = returnFunc(json1)
+	var r0 TSigned
+	var r1 TIntf
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
+		return returnFunc(v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
+		r0 = returnFunc(v, v1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
+			r0 = ret.Get(0).(TSigned)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
+		r1 = returnFunc(v, v1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(TIntf)
+		}
+	}
+	return r0, r1
 }
 
-// MockRequesterArgSameAsImport_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterArgSameAsImport_Get_Call struct {
+// MockRequesterGenericsTypedArgs_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
+type MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - json1
-func (_e *MockRequesterArgSameAsImport_Expecter) Get(json1 interface{}) *MockRequesterArgSameAsImport_Get_Call {
-	return &MockRequesterArgSameAsImport_Get_Call{Call: _e.mock.On("Get", json1)}
+// GenericArguments is a helper method to define mock.On call
+//   - v
+//   - v1
+func (_e *MockRequesterGenericsTypedArgs_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v arg.Arg[TAny], v1 arg.Arg[TComparable]) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v.Matcher(), v1.Matcher())}
 }
 
-func (_c *MockRequesterArgSameAsImport_Get_Call) Run(run func(json1 string)) *MockRequesterArgSameAsImport_Get_Call {
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(TAny), args[1].(TComparable))
 	})
 	return _c
 }
 
-func (_c *MockRequesterArgSameAsImport_Get_Call) Return(rawMessage *json.RawMessage) *MockRequesterArgSameAsImport_Get_Call {
-	_c.Call.Return(rawMessage)
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(v2, v3)
 	return _c
 }
 
-func (_c *MockRequesterArgSameAsImport_Get_Call) RunAndReturn(run func(json1 string) *json.RawMessage) *MockRequesterArgSameAsImport_Get_Call {
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterArgSameAsNamedImport creates a new instance of MockRequesterArgSameAsNamedImport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterArgSameAsNamedImport(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterArgSameAsNamedImport {
-	mock := &MockRequesterArgSameAsNamedImport{}
-	mock.Mock.Test(t)
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterArgSameAsNamedImport is an autogenerated mock type for the RequesterArgSameAsNamedImport type
-type MockRequesterArgSameAsNamedImport struct {
-	mock.Mock
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockRequesterArgSameAsNamedImport_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockRequesterArgSameAsNamedImport) EXPECT() *MockRequesterArgSameAsNamedImport_Expecter {
-	return &MockRequesterArgSameAsNamedImport_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterArgSameAsNamedImport
-func (_mock *MockRequesterArgSameAsNamedImport) Get(json1 string) *json.RawMessage {
-	ret := _mock.Called(json1)
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsTypedArgs_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericStructs provides a mock function for the type MockRequesterGenericsTypedArgs
+func (_mock *MockRequesterGenericsTypedArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
+	ret := _mock.Called(genericType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericStructs")
 	}
 
-	var r0 *json.RawMessage
-	if returnFunc, ok := ret.Get(0).(func(string) *json.RawMessage); ok {
-		r0 = returnFunc(json1)
+	var r0 GenericType[TSigned, TIntf]
+	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
+		r0 = returnFunc(genericType)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*json.RawMessage)
-		}
+		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
 	}
 	return r0
 }
 
-// MockRequesterArgSameAsNamedImport_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterArgSameAsNamedImport_Get_Call struct {
+// MockRequesterGenericsTypedArgs_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
+type MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - json1
-func (_e *MockRequesterArgSameAsNamedImport_Expecter) Get(json1 interface{}) *MockRequesterArgSameAsNamedImport_Get_Call {
-	return &MockRequesterArgSameAsNamedImport_Get_Call{Call: _e.mock.On("Get", json1)}
+// GenericStructs is a helper method to define mock.On call
+//   - genericType
+func (_e *MockRequesterGenericsTypedArgs_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType arg.Arg[GenericType[TAny, TIntf]]) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType.Matcher())}
 }
 
-func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Run(run func(json1 string)) *MockRequesterArgSameAsNamedImport_Get_Call {
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(GenericType[TAny, TIntf]))
 	})
 	return _c
 }
 
-func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Return(rawMessage *json.RawMessage) *MockRequesterArgSameAsNamedImport_Get_Call {
-	_c.Call.Return(rawMessage)
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(genericType1)
 	return _c
 }
 
-func (_c *MockRequesterArgSameAsNamedImport_Get_Call) RunAndReturn(run func(json1 string) *json.RawMessage) *MockRequesterArgSameAsNamedImport_Get_Call {
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterArgSameAsPkg creates a new instance of MockRequesterArgSameAsPkg. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterArgSameAsPkg(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterArgSameAsPkg {
-	mock := &MockRequesterArgSameAsPkg{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockRequesterArgSameAsPkg is an autogenerated mock type for the RequesterArgSameAsPkg type
-type MockRequesterArgSameAsPkg struct {
-	mock.Mock
-}
-
-type MockRequesterArgSameAsPkg_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *MockRequesterArgSameAsPkg) EXPECT() *MockRequesterArgSameAsPkg_Expecter {
-	return &MockRequesterArgSameAsPkg_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterArgSameAsPkg
-func (_mock *MockRequesterArgSameAsPkg) Get(test1 string) {
-	_mock.Called(test1)
-	return
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterArgSameAsPkg_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterArgSameAsPkg_Get_Call struct {
-	*mock.Call
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Get is a helper method to define mock.On call
-//   - test1
-func (_e *MockRequesterArgSameAsPkg_Expecter) Get(test1 interface{}) *MockRequesterArgSameAsPkg_Get_Call {
-	return &MockRequesterArgSameAsPkg_Get_Call{Call: _e.mock.On("Get", test1)}
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockRequesterArgSameAsPkg_Get_Call) Run(run func(test1 string)) *MockRequesterArgSameAsPkg_Get_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockRequesterArgSameAsPkg_Get_Call) Return() *MockRequesterArgSameAsPkg_Get_Call {
-	_c.Call.Return()
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockRequesterArgSameAsPkg_Get_Call) RunAndReturn(run func(test1 string)) *MockRequesterArgSameAsPkg_Get_Call {
-	_c.Run(run)
+func (_c *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsTypedArgs_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockRequesterArray creates a new instance of MockRequesterArray. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRequesterGenericsTypedCalls creates a new instance of MockRequesterGenericsTypedCalls. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRequesterArray(t interface {
+func NewMockRequesterGenericsTypedCalls[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRequesterArray {
-	mock := &MockRequesterArray{}
+}) *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := &MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -3486,392 +4039,423 @@ func NewMockRequesterArray(t interface {
 	return mock
 }
 
-// MockRequesterArray is an autogenerated mock type for the RequesterArray type
-type MockRequesterArray struct {
+// MockRequesterGenericsTypedCalls is an autogenerated mock type for the RequesterGenerics type
+type MockRequesterGenericsTypedCalls[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock.Mock
+	typedCallsMu sync.RWMutex
+	typedCalls   struct {
+		GenericAnonymousStructs []MockRequesterGenericsTypedCalls_GenericAnonymousStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]
+		GenericArguments        []MockRequesterGenericsTypedCalls_GenericArguments_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]
+		GenericStructs          []MockRequesterGenericsTypedCalls_GenericStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]
+	}
 }
 
-type MockRequesterArray_Expecter struct {
+type MockRequesterGenericsTypedCalls_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRequesterArray) EXPECT() *MockRequesterArray_Expecter {
-	return &MockRequesterArray_Expecter{mock: &_m.Mock}
+func (_m *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenericsTypedCalls_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedCalls_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockRequesterArray
-func (_mock *MockRequesterArray) Get(path string) ([2]string, error) {
-	ret := _mock.Called(path)
+// GenericAnonymousStructs provides a mock function for the type MockRequesterGenericsTypedCalls
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+} {
+	ret := _mock.Called(val)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.GenericAnonymousStructs = append(_mock.typedCalls.GenericAnonymousStructs, MockRequesterGenericsTypedCalls_GenericAnonymousStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{
+		Val: val,
+	})
+	_mock.typedCallsMu.Unlock()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericAnonymousStructs")
 	}
 
-	var r0 [2]string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([2]string, error)); ok {
-		return returnFunc(path)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) [2]string); ok {
-		r0 = returnFunc(path)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([2]string)
-		}
+	var r0 struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}); ok {
+		r0 = returnFunc(val)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(struct {
+			Type2 GenericType[string, EmbeddedGet[int]]
+		})
 	}
-	return r0, r1
+	return r0
 }
 
-// MockRequesterArray_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterArray_Get_Call struct {
+// MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
+type MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequesterArray_Expecter) Get(path interface{}) *MockRequesterArray_Get_Call {
-	return &MockRequesterArray_Get_Call{Call: _e.mock.On("Get", path)}
+// GenericAnonymousStructs is a helper method to define mock.On call
+//   - val
+func (_e *MockRequesterGenericsTypedCalls_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val interface{}) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val)}
 }
 
-func (_c *MockRequesterArray_Get_Call) Run(run func(path string)) *MockRequesterArray_Get_Call {
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(struct{ Type1 TExternalIntf }))
 	})
 	return _c
 }
 
-func (_c *MockRequesterArray_Get_Call) Return(strings [2]string, err error) *MockRequesterArray_Get_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(val1)
 	return _c
 }
 
-func (_c *MockRequesterArray_Get_Call) RunAndReturn(run func(path string) ([2]string, error)) *MockRequesterArray_Get_Call {
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterElided creates a new instance of MockRequesterElided. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterElided(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterElided {
-	mock := &MockRequesterElided{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockRequesterElided is an autogenerated mock type for the RequesterElided type
-type MockRequesterElided struct {
-	mock.Mock
-}
-
-type MockRequesterElided_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *MockRequesterElided) EXPECT() *MockRequesterElided_Expecter {
-	return &MockRequesterElided_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterElided
-func (_mock *MockRequesterElided) Get(path string, url string) error {
-	ret := _mock.Called(path, url)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = returnFunc(path, url)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterElided_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterElided_Get_Call struct {
-	*mock.Call
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-//   - url
-func (_e *MockRequesterElided_Expecter) Get(path interface{}, url interface{}) *MockRequesterElided_Get_Call {
-	return &MockRequesterElided_Get_Call{Call: _e.mock.On("Get", path, url)}
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *MockRequesterElided_Get_Call) Run(run func(path string, url string)) *MockRequesterElided_Get_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
-	})
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *MockRequesterElided_Get_Call) Return(err error) *MockRequesterElided_Get_Call {
-	_c.Call.Return(err)
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *MockRequesterElided_Get_Call) RunAndReturn(run func(path string, url string) error) *MockRequesterElided_Get_Call {
-	_c.Call.Return(run)
+func (_c *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsTypedCalls_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockRequesterIface creates a new instance of MockRequesterIface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterIface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterIface {
-	mock := &MockRequesterIface{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+// MockRequesterGenericsTypedCalls_GenericAnonymousStructs_CallArgs holds the arguments of a call to GenericAnonymousStructs.
+type MockRequesterGenericsTypedCalls_GenericAnonymousStructs_CallArgs[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	Val struct{ Type1 TExternalIntf }
+}
 
-	return mock
+// GenericAnonymousStructsCalls returns the arguments of the calls made to GenericAnonymousStructs, in order.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructsCalls() []MockRequesterGenericsTypedCalls_GenericAnonymousStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterGenericsTypedCalls_GenericAnonymousStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric](nil), _mock.typedCalls.GenericAnonymousStructs...)
 }
 
-// MockRequesterIface is an autogenerated mock type for the RequesterIface type
-type MockRequesterIface struct {
-	mock.Mock
+// AssertGenericAnonymousStructsCalled asserts that GenericAnonymousStructs was called with the given arguments.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) AssertGenericAnonymousStructsCalled(t mock.TestingT, val struct{ Type1 TExternalIntf }) bool {
+	return _mock.Mock.AssertCalled(t, "GenericAnonymousStructs", val)
 }
 
-type MockRequesterIface_Expecter struct {
-	mock *mock.Mock
+// AssertGenericAnonymousStructsNotCalled asserts that GenericAnonymousStructs was not called with the given arguments.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) AssertGenericAnonymousStructsNotCalled(t mock.TestingT, val struct{ Type1 TExternalIntf }) bool {
+	return _mock.Mock.AssertNotCalled(t, "GenericAnonymousStructs", val)
 }
 
-func (_m *MockRequesterIface) EXPECT() *MockRequesterIface_Expecter {
-	return &MockRequesterIface_Expecter{mock: &_m.Mock}
-}
-
-// Get provides a mock function for the type MockRequesterIface
-func (_mock *MockRequesterIface) Get() io.Reader {
-	ret := _mock.Called()
+// GenericArguments provides a mock function for the type MockRequesterGenericsTypedCalls
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
+	ret := _mock.Called(v, v1)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.GenericArguments = append(_mock.typedCalls.GenericArguments, MockRequesterGenericsTypedCalls_GenericArguments_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{
+		V:  v,
+		V1: v1,
+	})
+	_mock.typedCallsMu.Unlock()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericArguments")
 	}
 
-	var r0 io.Reader
-	if returnFunc, ok := ret.Get(0).(func() io.Reader); ok {
-		r0 = returnFunc()
+	var r0 TSigned
+	var r1 TIntf
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
+		return returnFunc(v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
+		r0 = returnFunc(v, v1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(io.Reader)
+			r0 = ret.Get(0).(TSigned)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
+		r1 = returnFunc(v, v1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(TIntf)
+		}
+	}
+	return r0, r1
 }
 
-// MockRequesterIface_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterIface_Get_Call struct {
+// MockRequesterGenericsTypedCalls_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
+type MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-func (_e *MockRequesterIface_Expecter) Get() *MockRequesterIface_Get_Call {
-	return &MockRequesterIface_Get_Call{Call: _e.mock.On("Get")}
+// GenericArguments is a helper method to define mock.On call
+//   - v
+//   - v1
+func (_e *MockRequesterGenericsTypedCalls_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v interface{}, v1 interface{}) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v, v1)}
 }
 
-func (_c *MockRequesterIface_Get_Call) Run(run func()) *MockRequesterIface_Get_Call {
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(TAny), args[1].(TComparable))
 	})
 	return _c
 }
 
-func (_c *MockRequesterIface_Get_Call) Return(reader io.Reader) *MockRequesterIface_Get_Call {
-	_c.Call.Return(reader)
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(v2, v3)
 	return _c
 }
 
-func (_c *MockRequesterIface_Get_Call) RunAndReturn(run func() io.Reader) *MockRequesterIface_Get_Call {
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterNS creates a new instance of MockRequesterNS. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterNS(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterNS {
-	mock := &MockRequesterNS{}
-	mock.Mock.Test(t)
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterNS is an autogenerated mock type for the RequesterNS type
-type MockRequesterNS struct {
-	mock.Mock
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockRequesterNS_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockRequesterNS) EXPECT() *MockRequesterNS_Expecter {
-	return &MockRequesterNS_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterNS
-func (_mock *MockRequesterNS) Get(path string) (http.Response, error) {
-	ret := _mock.Called(path)
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsTypedCalls_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterGenericsTypedCalls_GenericArguments_CallArgs holds the arguments of a call to GenericArguments.
+type MockRequesterGenericsTypedCalls_GenericArguments_CallArgs[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	V  TAny
+	V1 TComparable
+}
+
+// GenericArgumentsCalls returns the arguments of the calls made to GenericArguments, in order.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArgumentsCalls() []MockRequesterGenericsTypedCalls_GenericArguments_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterGenericsTypedCalls_GenericArguments_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric](nil), _mock.typedCalls.GenericArguments...)
+}
+
+// AssertGenericArgumentsCalled asserts that GenericArguments was called with the given arguments.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) AssertGenericArgumentsCalled(t mock.TestingT, v TAny, v1 TComparable) bool {
+	return _mock.Mock.AssertCalled(t, "GenericArguments", v, v1)
+}
+
+// AssertGenericArgumentsNotCalled asserts that GenericArguments was not called with the given arguments.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) AssertGenericArgumentsNotCalled(t mock.TestingT, v TAny, v1 TComparable) bool {
+	return _mock.Mock.AssertNotCalled(t, "GenericArguments", v, v1)
+}
+
+// GenericStructs provides a mock function for the type MockRequesterGenericsTypedCalls
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
+	ret := _mock.Called(genericType)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.GenericStructs = append(_mock.typedCalls.GenericStructs, MockRequesterGenericsTypedCalls_GenericStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{
+		GenericType: genericType,
+	})
+	_mock.typedCallsMu.Unlock()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericStructs")
 	}
 
-	var r0 http.Response
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (http.Response, error)); ok {
-		return returnFunc(path)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) http.Response); ok {
-		r0 = returnFunc(path)
-	} else {
-		r0 = ret.Get(0).(http.Response)
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	var r0 GenericType[TSigned, TIntf]
+	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
+		r0 = returnFunc(genericType)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
 	}
-	return r0, r1
+	return r0
 }
 
-// MockRequesterNS_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterNS_Get_Call struct {
+// MockRequesterGenericsTypedCalls_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
+type MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequesterNS_Expecter) Get(path interface{}) *MockRequesterNS_Get_Call {
-	return &MockRequesterNS_Get_Call{Call: _e.mock.On("Get", path)}
+// GenericStructs is a helper method to define mock.On call
+//   - genericType
+func (_e *MockRequesterGenericsTypedCalls_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType interface{}) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType)}
 }
 
-func (_c *MockRequesterNS_Get_Call) Run(run func(path string)) *MockRequesterNS_Get_Call {
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(GenericType[TAny, TIntf]))
 	})
 	return _c
 }
 
-func (_c *MockRequesterNS_Get_Call) Return(response http.Response, err error) *MockRequesterNS_Get_Call {
-	_c.Call.Return(response, err)
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(genericType1)
 	return _c
 }
 
-func (_c *MockRequesterNS_Get_Call) RunAndReturn(run func(path string) (http.Response, error)) *MockRequesterNS_Get_Call {
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterPtr creates a new instance of MockRequesterPtr. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterPtr(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterPtr {
-	mock := &MockRequesterPtr{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
 }
 
-// MockRequesterPtr is an autogenerated mock type for the RequesterPtr type
-type MockRequesterPtr struct {
-	mock.Mock
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
 }
 
-type MockRequesterPtr_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
 }
 
-func (_m *MockRequesterPtr) EXPECT() *MockRequesterPtr_Expecter {
-	return &MockRequesterPtr_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterPtr
-func (_mock *MockRequesterPtr) Get(path string) (*string, error) {
-	ret := _mock.Called(path)
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
 
-	var r0 *string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*string, error)); ok {
-		return returnFunc(path)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) *string); ok {
-		r0 = returnFunc(path)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
 }
 
-// MockRequesterPtr_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterPtr_Get_Call struct {
-	*mock.Call
+func (_c *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsTypedCalls_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequesterPtr_Expecter) Get(path interface{}) *MockRequesterPtr_Get_Call {
-	return &MockRequesterPtr_Get_Call{Call: _e.mock.On("Get", path)}
+// MockRequesterGenericsTypedCalls_GenericStructs_CallArgs holds the arguments of a call to GenericStructs.
+type MockRequesterGenericsTypedCalls_GenericStructs_CallArgs[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	GenericType GenericType[TAny, TIntf]
 }
 
-func (_c *MockRequesterPtr_Get_Call) Run(run func(path string)) *MockRequesterPtr_Get_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
-	return _c
+// GenericStructsCalls returns the arguments of the calls made to GenericStructs, in order.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructsCalls() []MockRequesterGenericsTypedCalls_GenericStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterGenericsTypedCalls_GenericStructs_CallArgs[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric](nil), _mock.typedCalls.GenericStructs...)
 }
 
-func (_c *MockRequesterPtr_Get_Call) Return(s *string, err error) *MockRequesterPtr_Get_Call {
-	_c.Call.Return(s, err)
-	return _c
+// AssertGenericStructsCalled asserts that GenericStructs was called with the given arguments.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) AssertGenericStructsCalled(t mock.TestingT, genericType GenericType[TAny, TIntf]) bool {
+	return _mock.Mock.AssertCalled(t, "GenericStructs", genericType)
 }
 
-func (_c *MockRequesterPtr_Get_Call) RunAndReturn(run func(path string) (*string, error)) *MockRequesterPtr_Get_Call {
-	_c.Call.Return(run)
-	return _c
+// AssertGenericStructsNotCalled asserts that GenericStructs was not called with the given arguments.
+func (_mock *MockRequesterGenericsTypedCalls[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) AssertGenericStructsNotCalled(t mock.TestingT, genericType GenericType[TAny, TIntf]) bool {
+	return _mock.Mock.AssertNotCalled(t, "GenericStructs", genericType)
 }
 
-// NewMockRequesterReturnElided creates a new instance of MockRequesterReturnElided. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRequesterGenericsZeroDefaults creates a new instance of MockRequesterGenericsZeroDefaults. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRequesterReturnElided(t interface {
+func NewMockRequesterGenericsZeroDefaults[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRequesterReturnElided {
-	mock := &MockRequesterReturnElided{}
+}) *MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := &MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -3879,289 +4463,10479 @@ func NewMockRequesterReturnElided(t interface {
 	return mock
 }
 
-// MockRequesterReturnElided is an autogenerated mock type for the RequesterReturnElided type
-type MockRequesterReturnElided struct {
+// MockRequesterGenericsZeroDefaults is an autogenerated mock type for the RequesterGenerics type
+type MockRequesterGenericsZeroDefaults[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock.Mock
+	zeroMu    sync.Mutex
+	zeroCalls map[*mock.Call]bool
 }
 
-type MockRequesterReturnElided_Expecter struct {
+type MockRequesterGenericsZeroDefaults_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRequesterReturnElided) EXPECT() *MockRequesterReturnElided_Expecter {
-	return &MockRequesterReturnElided_Expecter{mock: &_m.Mock}
+func (_m *MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenericsZeroDefaults_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsZeroDefaults_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockRequesterReturnElided
-func (_mock *MockRequesterReturnElided) Get(path string) (int, int, int, error) {
-	ret := _mock.Called(path)
+// _calledOrZero calls the mocked method. If no expectation matches the arguments,
+// or all the matching ones are used up, it first sets up a one-off expectation
+// returning rets, so that unexpected calls return zero values and are still
+// recorded.
+func (_mock *MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) _calledOrZero(method string, rets []interface{}, args ...interface{}) mock.Arguments {
+	_mock.zeroMu.Lock()
+	matched := false
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.zeroCalls[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		if _mock.zeroCalls == nil {
+			_mock.zeroCalls = make(map[*mock.Call]bool)
+		}
+		_mock.zeroCalls[_mock.On(method, args...).Return(rets...).Once().Maybe()] = true
+	}
+	_mock.zeroMu.Unlock()
+
+	return _mock.MethodCalled(method, args...)
+}
+
+// GenericAnonymousStructs provides a mock function for the type MockRequesterGenericsZeroDefaults
+func (_mock *MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+} {
+	ret := _mock._calledOrZero("GenericAnonymousStructs", []interface{}{struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}{}}, val)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GenericAnonymousStructs")
 	}
 
-	var r0 int
-	var r1 int
-	var r2 int
-	var r3 error
-	if returnFunc, ok := ret.Get(0).(func(string) (int, int, int, error)); ok {
-		return returnFunc(path)
+	var r0 struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
-		r0 = returnFunc(path)
-	} else {
-		r0 = ret.Get(0).(int)
+	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}); ok {
+		r0 = returnFunc(val)
+	} else {
+		r0 = ret.Get(0).(struct {
+			Type2 GenericType[string, EmbeddedGet[int]]
+		})
+	}
+	return r0
+}
+
+// MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
+type MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericAnonymousStructs is a helper method to define mock.On call
+//   - val
+func (_e *MockRequesterGenericsZeroDefaults_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val interface{}) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val)}
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(struct{ Type1 TExternalIntf }))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(val1)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsZeroDefaults_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericArguments provides a mock function for the type MockRequesterGenericsZeroDefaults
+func (_mock *MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
+	ret := _mock._calledOrZero("GenericArguments", []interface{}{*new(TSigned), *new(TIntf)}, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericArguments")
+	}
+
+	var r0 TSigned
+	var r1 TIntf
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
+		return returnFunc(v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
+		r0 = returnFunc(v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TSigned)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
+		r1 = returnFunc(v, v1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(TIntf)
+		}
+	}
+	return r0, r1
+}
+
+// MockRequesterGenericsZeroDefaults_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
+type MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericArguments is a helper method to define mock.On call
+//   - v
+//   - v1
+func (_e *MockRequesterGenericsZeroDefaults_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v interface{}, v1 interface{}) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v, v1)}
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(TAny), args[1].(TComparable))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(v2, v3)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsZeroDefaults_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericStructs provides a mock function for the type MockRequesterGenericsZeroDefaults
+func (_mock *MockRequesterGenericsZeroDefaults[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
+	ret := _mock._calledOrZero("GenericStructs", []interface{}{GenericType[TSigned, TIntf]{}}, genericType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericStructs")
+	}
+
+	var r0 GenericType[TSigned, TIntf]
+	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
+		r0 = returnFunc(genericType)
+	} else {
+		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
+	}
+	return r0
+}
+
+// MockRequesterGenericsZeroDefaults_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
+type MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericStructs is a helper method to define mock.On call
+//   - genericType
+func (_e *MockRequesterGenericsZeroDefaults_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType interface{}) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType)}
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(GenericType[TAny, TIntf]))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(genericType1)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsZeroDefaults_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterGenericsWaitHelpers creates a new instance of MockRequesterGenericsWaitHelpers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterGenericsWaitHelpers[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := &MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterGenericsWaitHelpers is an autogenerated mock type for the RequesterGenerics type
+type MockRequesterGenericsWaitHelpers[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	mock.Mock
+	awaitCalls await.Calls
+}
+
+type MockRequesterGenericsWaitHelpers_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenericsWaitHelpers_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsWaitHelpers_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
+}
+
+// WaitForExpectations waits until all the expectations of the mock are met, and
+// reports whether they were before ctx was done.
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitForExpectations(ctx context.Context) bool {
+	return _mock.awaitCalls.Expectations(ctx, &_mock.Mock)
+}
+
+// GenericAnonymousStructs provides a mock function for the type MockRequesterGenericsWaitHelpers
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+} {
+	defer _mock.awaitCalls.Record("GenericAnonymousStructs")
+	ret := _mock.Called(val)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericAnonymousStructs")
+	}
+
+	var r0 struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}
+	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}); ok {
+		r0 = returnFunc(val)
+	} else {
+		r0 = ret.Get(0).(struct {
+			Type2 GenericType[string, EmbeddedGet[int]]
+		})
+	}
+	return r0
+}
+
+// MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
+type MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericAnonymousStructs is a helper method to define mock.On call
+//   - val
+func (_e *MockRequesterGenericsWaitHelpers_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val interface{}) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val)}
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(struct{ Type1 TExternalIntf }))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(val1)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// Notify sends on ch every time a call matches this expectation, after the
+// function set by Run, if any. Notify must be called after Run.
+func (_c *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Notify(ch chan<- struct{}) *MockRequesterGenericsWaitHelpers_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	run := _c.Call.RunFn
+	_c.Call.Run(func(args mock.Arguments) {
+		if run != nil {
+			run(args)
+		}
+		ch <- struct{}{}
+	})
+	return _c
+}
+
+// WaitForGenericAnonymousStructs waits up to timeout for a call to GenericAnonymousStructs, and reports
+// whether one was made.
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitForGenericAnonymousStructs(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return _mock.awaitCalls.Called(ctx, "GenericAnonymousStructs")
+}
+
+// GenericArguments provides a mock function for the type MockRequesterGenericsWaitHelpers
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
+	defer _mock.awaitCalls.Record("GenericArguments")
+	ret := _mock.Called(v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericArguments")
+	}
+
+	var r0 TSigned
+	var r1 TIntf
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
+		return returnFunc(v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
+		r0 = returnFunc(v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TSigned)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
+		r1 = returnFunc(v, v1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(TIntf)
+		}
+	}
+	return r0, r1
+}
+
+// MockRequesterGenericsWaitHelpers_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
+type MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericArguments is a helper method to define mock.On call
+//   - v
+//   - v1
+func (_e *MockRequesterGenericsWaitHelpers_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v interface{}, v1 interface{}) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v, v1)}
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(TAny), args[1].(TComparable))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(v2, v3)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// Notify sends on ch every time a call matches this expectation, after the
+// function set by Run, if any. Notify must be called after Run.
+func (_c *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Notify(ch chan<- struct{}) *MockRequesterGenericsWaitHelpers_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	run := _c.Call.RunFn
+	_c.Call.Run(func(args mock.Arguments) {
+		if run != nil {
+			run(args)
+		}
+		ch <- struct{}{}
+	})
+	return _c
+}
+
+// WaitForGenericArguments waits up to timeout for a call to GenericArguments, and reports
+// whether one was made.
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitForGenericArguments(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return _mock.awaitCalls.Called(ctx, "GenericArguments")
+}
+
+// GenericStructs provides a mock function for the type MockRequesterGenericsWaitHelpers
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
+	defer _mock.awaitCalls.Record("GenericStructs")
+	ret := _mock.Called(genericType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericStructs")
+	}
+
+	var r0 GenericType[TSigned, TIntf]
+	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
+		r0 = returnFunc(genericType)
+	} else {
+		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
+	}
+	return r0
+}
+
+// MockRequesterGenericsWaitHelpers_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
+type MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericStructs is a helper method to define mock.On call
+//   - genericType
+func (_e *MockRequesterGenericsWaitHelpers_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType interface{}) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType)}
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(GenericType[TAny, TIntf]))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(genericType1)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// Notify sends on ch every time a call matches this expectation, after the
+// function set by Run, if any. Notify must be called after Run.
+func (_c *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Notify(ch chan<- struct{}) *MockRequesterGenericsWaitHelpers_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	run := _c.Call.RunFn
+	_c.Call.Run(func(args mock.Arguments) {
+		if run != nil {
+			run(args)
+		}
+		ch <- struct{}{}
+	})
+	return _c
+}
+
+// WaitForGenericStructs waits up to timeout for a call to GenericStructs, and reports
+// whether one was made.
+func (_mock *MockRequesterGenericsWaitHelpers[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitForGenericStructs(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return _mock.awaitCalls.Called(ctx, "GenericStructs")
+}
+
+// NewMockRequesterGenericsPartial creates a new instance of MockRequesterGenericsPartial. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterGenericsPartial[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := &MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// NewMockRequesterGenericsPartialWithDelegate creates a new instance of MockRequesterGenericsPartial like NewMockRequesterGenericsPartial.
+// Calls that match no expectation are forwarded to delegate, and still recorded.
+func NewMockRequesterGenericsPartialWithDelegate[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}](t interface {
+	mock.TestingT
+	Cleanup(func())
+}, delegate RequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	mock := NewMockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric](t)
+	mock.delegate = delegate
+	return mock
+}
+
+// MockRequesterGenericsPartial is an autogenerated mock type for the RequesterGenerics type
+type MockRequesterGenericsPartial[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	mock.Mock
+	delegate   RequesterGenerics[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]
+	delegateMu sync.Mutex
+	delegated  map[*mock.Call]bool
+}
+
+type MockRequesterGenericsPartial_Expecter[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) EXPECT() *MockRequesterGenericsPartial_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsPartial_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{mock: &_m.Mock}
+}
+
+// _delegateCall reports whether a call should be forwarded to the delegate, which
+// is the case when there is one and no expectation matches the arguments, or all
+// the matching ones are used up. The
+// forwarded call is recorded through a one-off expectation, so that assertions
+// on the calls of the mock still see it.
+func (_mock *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) _delegateCall(method string, args ...interface{}) bool {
+	if _mock.delegate == nil {
+		return false
+	}
+	_mock.delegateMu.Lock()
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.delegated[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			_mock.delegateMu.Unlock()
+			return false
+		}
+	}
+	anything := make([]interface{}, len(args))
+	for i := range anything {
+		anything[i] = mock.Anything
+	}
+	if _mock.delegated == nil {
+		_mock.delegated = make(map[*mock.Call]bool)
+	}
+	_mock.delegated[_mock.On(method, anything...).Once().Maybe()] = true
+	_mock.delegateMu.Unlock()
+
+	_mock.MethodCalled(method, args...)
+	return true
+}
+
+// GenericAnonymousStructs provides a mock function for the type MockRequesterGenericsPartial
+func (_mock *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+} {
+	if _mock._delegateCall("GenericAnonymousStructs", val) {
+		return _mock.delegate.GenericAnonymousStructs(val)
+	}
+	ret := _mock.Called(val)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericAnonymousStructs")
+	}
+
+	var r0 struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}
+	if returnFunc, ok := ret.Get(0).(func(struct{ Type1 TExternalIntf }) struct {
+		Type2 GenericType[string, EmbeddedGet[int]]
+	}); ok {
+		r0 = returnFunc(val)
+	} else {
+		r0 = ret.Get(0).(struct {
+			Type2 GenericType[string, EmbeddedGet[int]]
+		})
+	}
+	return r0
+}
+
+// MockRequesterGenericsPartial_GenericAnonymousStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericAnonymousStructs'
+type MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericAnonymousStructs is a helper method to define mock.On call
+//   - val
+func (_e *MockRequesterGenericsPartial_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericAnonymousStructs(val interface{}) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericAnonymousStructs", val)}
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(val struct{ Type1 TExternalIntf })) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(struct{ Type1 TExternalIntf }))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(val1 struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(val1)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(val struct{ Type1 TExternalIntf }) struct {
+	Type2 GenericType[string, EmbeddedGet[int]]
+}) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsPartial_GenericAnonymousStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericArguments provides a mock function for the type MockRequesterGenericsPartial
+func (_mock *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v TAny, v1 TComparable) (TSigned, TIntf) {
+	if _mock._delegateCall("GenericArguments", v, v1) {
+		return _mock.delegate.GenericArguments(v, v1)
+	}
+	ret := _mock.Called(v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericArguments")
+	}
+
+	var r0 TSigned
+	var r1 TIntf
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) (TSigned, TIntf)); ok {
+		return returnFunc(v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(TAny, TComparable) TSigned); ok {
+		r0 = returnFunc(v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TSigned)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(TAny, TComparable) TIntf); ok {
+		r1 = returnFunc(v, v1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(TIntf)
+		}
+	}
+	return r0, r1
+}
+
+// MockRequesterGenericsPartial_GenericArguments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericArguments'
+type MockRequesterGenericsPartial_GenericArguments_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericArguments is a helper method to define mock.On call
+//   - v
+//   - v1
+func (_e *MockRequesterGenericsPartial_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericArguments(v interface{}, v1 interface{}) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericArguments", v, v1)}
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(v TAny, v1 TComparable)) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(TAny), args[1].(TComparable))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(v2 TSigned, v3 TIntf) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(v2, v3)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(v TAny, v1 TComparable) (TSigned, TIntf)) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsPartial_GenericArguments_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// GenericStructs provides a mock function for the type MockRequesterGenericsPartial
+func (_mock *MockRequesterGenericsPartial[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf] {
+	if _mock._delegateCall("GenericStructs", genericType) {
+		return _mock.delegate.GenericStructs(genericType)
+	}
+	ret := _mock.Called(genericType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenericStructs")
+	}
+
+	var r0 GenericType[TSigned, TIntf]
+	if returnFunc, ok := ret.Get(0).(func(GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]); ok {
+		r0 = returnFunc(genericType)
+	} else {
+		r0 = ret.Get(0).(GenericType[TSigned, TIntf])
+	}
+	return r0
+}
+
+// MockRequesterGenericsPartial_GenericStructs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenericStructs'
+type MockRequesterGenericsPartial_GenericStructs_Call[TAny any, TComparable comparable, TSigned constraints.Signed, TIntf GetInt, TExternalIntf io.Writer, TGenIntf GetGeneric[TSigned], TInlineType interface{ ~int | ~uint }, TInlineTypeGeneric interface {
+	~int | GenericType[int, GetInt]
+	comparable
+}] struct {
+	*mock.Call
+}
+
+// GenericStructs is a helper method to define mock.On call
+//   - genericType
+func (_e *MockRequesterGenericsPartial_Expecter[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) GenericStructs(genericType interface{}) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	return &MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]{Call: _e.mock.On("GenericStructs", genericType)}
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Run(run func(genericType GenericType[TAny, TIntf])) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(GenericType[TAny, TIntf]))
+	})
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Return(genericType1 GenericType[TSigned, TIntf]) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(genericType1)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) RunAndReturn(run func(genericType GenericType[TAny, TIntf]) GenericType[TSigned, TIntf]) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Once() *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Twice() *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Times(i int) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Maybe() *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) After(d time.Duration) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) WaitUntil(w <-chan time.Time) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) NotBefore(calls ...*mock.Call) *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric]) Unset() *MockRequesterGenericsPartial_GenericStructs_Call[TAny, TComparable, TSigned, TIntf, TExternalIntf, TGenIntf, TInlineType, TInlineTypeGeneric] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockGetInt creates a new instance of MockGetInt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetInt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetInt {
+	mock := &MockGetInt{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetInt is an autogenerated mock type for the GetInt type
+type MockGetInt struct {
+	mock.Mock
+}
+
+type MockGetInt_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetInt) EXPECT() *MockGetInt_Expecter {
+	return &MockGetInt_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockGetInt
+func (_mock *MockGetInt) Get() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockGetInt_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockGetInt_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockGetInt_Expecter) Get() *MockGetInt_Get_Call {
+	return &MockGetInt_Get_Call{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockGetInt_Get_Call) Run(run func()) *MockGetInt_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) Return(n int) *MockGetInt_Get_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) RunAndReturn(run func() int) *MockGetInt_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) Once() *MockGetInt_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) Twice() *MockGetInt_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) Times(i int) *MockGetInt_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) Maybe() *MockGetInt_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) After(d time.Duration) *MockGetInt_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) WaitUntil(w <-chan time.Time) *MockGetInt_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) NotBefore(calls ...*mock.Call) *MockGetInt_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockGetInt_Get_Call) Unset() *MockGetInt_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockGetGeneric creates a new instance of MockGetGeneric. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetGeneric[T constraints.Integer](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetGeneric[T] {
+	mock := &MockGetGeneric[T]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetGeneric is an autogenerated mock type for the GetGeneric type
+type MockGetGeneric[T constraints.Integer] struct {
+	mock.Mock
+}
+
+type MockGetGeneric_Expecter[T constraints.Integer] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetGeneric[T]) EXPECT() *MockGetGeneric_Expecter[T] {
+	return &MockGetGeneric_Expecter[T]{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockGetGeneric
+func (_mock *MockGetGeneric[T]) Get() T {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 T
+	if returnFunc, ok := ret.Get(0).(func() T); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(T)
+		}
+	}
+	return r0
+}
+
+// MockGetGeneric_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockGetGeneric_Get_Call[T constraints.Integer] struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockGetGeneric_Expecter[T]) Get() *MockGetGeneric_Get_Call[T] {
+	return &MockGetGeneric_Get_Call[T]{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Run(run func()) *MockGetGeneric_Get_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Return(v T) *MockGetGeneric_Get_Call[T] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) RunAndReturn(run func() T) *MockGetGeneric_Get_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Once() *MockGetGeneric_Get_Call[T] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Twice() *MockGetGeneric_Get_Call[T] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Times(i int) *MockGetGeneric_Get_Call[T] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Maybe() *MockGetGeneric_Get_Call[T] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) After(d time.Duration) *MockGetGeneric_Get_Call[T] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) WaitUntil(w <-chan time.Time) *MockGetGeneric_Get_Call[T] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) NotBefore(calls ...*mock.Call) *MockGetGeneric_Get_Call[T] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockGetGeneric_Get_Call[T]) Unset() *MockGetGeneric_Get_Call[T] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockEmbeddedGet creates a new instance of MockEmbeddedGet. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmbeddedGet[T constraints.Signed](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmbeddedGet[T] {
+	mock := &MockEmbeddedGet[T]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEmbeddedGet is an autogenerated mock type for the EmbeddedGet type
+type MockEmbeddedGet[T constraints.Signed] struct {
+	mock.Mock
+}
+
+type MockEmbeddedGet_Expecter[T constraints.Signed] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmbeddedGet[T]) EXPECT() *MockEmbeddedGet_Expecter[T] {
+	return &MockEmbeddedGet_Expecter[T]{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockEmbeddedGet
+func (_mock *MockEmbeddedGet[T]) Get() T {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 T
+	if returnFunc, ok := ret.Get(0).(func() T); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(T)
+		}
+	}
+	return r0
+}
+
+// MockEmbeddedGet_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockEmbeddedGet_Get_Call[T constraints.Signed] struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockEmbeddedGet_Expecter[T]) Get() *MockEmbeddedGet_Get_Call[T] {
+	return &MockEmbeddedGet_Get_Call[T]{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Run(run func()) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Return(v T) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) RunAndReturn(run func() T) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Once() *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Twice() *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Times(i int) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Maybe() *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) After(d time.Duration) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) WaitUntil(w <-chan time.Time) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) NotBefore(calls ...*mock.Call) *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockEmbeddedGet_Get_Call[T]) Unset() *MockEmbeddedGet_Get_Call[T] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockReplaceGeneric creates a new instance of MockReplaceGeneric. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReplaceGeneric[TImport any, TConstraint constraints.Signed, TKeep any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReplaceGeneric[TImport, TConstraint, TKeep] {
+	mock := &MockReplaceGeneric[TImport, TConstraint, TKeep]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReplaceGeneric is an autogenerated mock type for the ReplaceGeneric type
+type MockReplaceGeneric[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+	mock.Mock
+}
+
+type MockReplaceGeneric_Expecter[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReplaceGeneric[TImport, TConstraint, TKeep]) EXPECT() *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep] {
+	return &MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]{mock: &_m.Mock}
+}
+
+// A provides a mock function for the type MockReplaceGeneric
+func (_mock *MockReplaceGeneric[TImport, TConstraint, TKeep]) A(t1 TImport) TKeep {
+	ret := _mock.Called(t1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for A")
+	}
+
+	var r0 TKeep
+	if returnFunc, ok := ret.Get(0).(func(TImport) TKeep); ok {
+		r0 = returnFunc(t1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TKeep)
+		}
+	}
+	return r0
+}
+
+// MockReplaceGeneric_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
+type MockReplaceGeneric_A_Call[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+	*mock.Call
+}
+
+// A is a helper method to define mock.On call
+//   - t1
+func (_e *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]) A(t1 interface{}) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	return &MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]{Call: _e.mock.On("A", t1)}
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Run(run func(t1 TImport)) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(TImport))
+	})
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Return(v TKeep) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) RunAndReturn(run func(t1 TImport) TKeep) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Once() *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Twice() *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Times(i int) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Maybe() *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) After(d time.Duration) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) WaitUntil(w <-chan time.Time) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) NotBefore(calls ...*mock.Call) *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep]) Unset() *MockReplaceGeneric_A_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Unset()
+	return _c
+}
+
+// B provides a mock function for the type MockReplaceGeneric
+func (_mock *MockReplaceGeneric[TImport, TConstraint, TKeep]) B() TImport {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for B")
+	}
+
+	var r0 TImport
+	if returnFunc, ok := ret.Get(0).(func() TImport); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TImport)
+		}
+	}
+	return r0
+}
+
+// MockReplaceGeneric_B_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'B'
+type MockReplaceGeneric_B_Call[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+	*mock.Call
+}
+
+// B is a helper method to define mock.On call
+func (_e *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]) B() *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	return &MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]{Call: _e.mock.On("B")}
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Run(run func()) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Return(v TImport) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) RunAndReturn(run func() TImport) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Once() *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Twice() *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Times(i int) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Maybe() *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) After(d time.Duration) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) WaitUntil(w <-chan time.Time) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) NotBefore(calls ...*mock.Call) *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep]) Unset() *MockReplaceGeneric_B_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Unset()
+	return _c
+}
+
+// C provides a mock function for the type MockReplaceGeneric
+func (_mock *MockReplaceGeneric[TImport, TConstraint, TKeep]) C() TConstraint {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for C")
+	}
+
+	var r0 TConstraint
+	if returnFunc, ok := ret.Get(0).(func() TConstraint); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TConstraint)
+		}
+	}
+	return r0
+}
+
+// MockReplaceGeneric_C_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'C'
+type MockReplaceGeneric_C_Call[TImport any, TConstraint constraints.Signed, TKeep any] struct {
+	*mock.Call
+}
+
+// C is a helper method to define mock.On call
+func (_e *MockReplaceGeneric_Expecter[TImport, TConstraint, TKeep]) C() *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	return &MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]{Call: _e.mock.On("C")}
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Run(run func()) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Return(v TConstraint) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) RunAndReturn(run func() TConstraint) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Once() *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Twice() *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Times(i int) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Maybe() *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) After(d time.Duration) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) WaitUntil(w <-chan time.Time) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) NotBefore(calls ...*mock.Call) *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep]) Unset() *MockReplaceGeneric_C_Call[TImport, TConstraint, TKeep] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockReplaceGenericSelf creates a new instance of MockReplaceGenericSelf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReplaceGenericSelf[T any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReplaceGenericSelf[T] {
+	mock := &MockReplaceGenericSelf[T]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReplaceGenericSelf is an autogenerated mock type for the ReplaceGenericSelf type
+type MockReplaceGenericSelf[T any] struct {
+	mock.Mock
+}
+
+type MockReplaceGenericSelf_Expecter[T any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReplaceGenericSelf[T]) EXPECT() *MockReplaceGenericSelf_Expecter[T] {
+	return &MockReplaceGenericSelf_Expecter[T]{mock: &_m.Mock}
+}
+
+// A provides a mock function for the type MockReplaceGenericSelf
+func (_mock *MockReplaceGenericSelf[T]) A() T {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for A")
+	}
+
+	var r0 T
+	if returnFunc, ok := ret.Get(0).(func() T); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(T)
+		}
+	}
+	return r0
+}
+
+// MockReplaceGenericSelf_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
+type MockReplaceGenericSelf_A_Call[T any] struct {
+	*mock.Call
+}
+
+// A is a helper method to define mock.On call
+func (_e *MockReplaceGenericSelf_Expecter[T]) A() *MockReplaceGenericSelf_A_Call[T] {
+	return &MockReplaceGenericSelf_A_Call[T]{Call: _e.mock.On("A")}
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Run(run func()) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Return(v T) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) RunAndReturn(run func() T) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Once() *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Twice() *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Times(i int) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Maybe() *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) After(d time.Duration) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) WaitUntil(w <-chan time.Time) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) NotBefore(calls ...*mock.Call) *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockReplaceGenericSelf_A_Call[T]) Unset() *MockReplaceGenericSelf_A_Call[T] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockHasConflictingNestedImports creates a new instance of MockHasConflictingNestedImports. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHasConflictingNestedImports(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHasConflictingNestedImports {
+	mock := &MockHasConflictingNestedImports{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHasConflictingNestedImports is an autogenerated mock type for the HasConflictingNestedImports type
+type MockHasConflictingNestedImports struct {
+	mock.Mock
+}
+
+type MockHasConflictingNestedImports_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHasConflictingNestedImports) EXPECT() *MockHasConflictingNestedImports_Expecter {
+	return &MockHasConflictingNestedImports_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockHasConflictingNestedImports
+func (_mock *MockHasConflictingNestedImports) Get(path string) (http.Response, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 http.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (http.Response, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) http.Response); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(http.Response)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHasConflictingNestedImports_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockHasConflictingNestedImports_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockHasConflictingNestedImports_Expecter) Get(path interface{}) *MockHasConflictingNestedImports_Get_Call {
+	return &MockHasConflictingNestedImports_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Run(run func(path string)) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Return(response http.Response, err error) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) RunAndReturn(run func(path string) (http.Response, error)) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Once() *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Twice() *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Times(i int) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Maybe() *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) After(d time.Duration) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) WaitUntil(w <-chan time.Time) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) NotBefore(calls ...*mock.Call) *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Get_Call) Unset() *MockHasConflictingNestedImports_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Z provides a mock function for the type MockHasConflictingNestedImports
+func (_mock *MockHasConflictingNestedImports) Z() http0.MyStruct {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Z")
+	}
+
+	var r0 http0.MyStruct
+	if returnFunc, ok := ret.Get(0).(func() http0.MyStruct); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(http0.MyStruct)
+	}
+	return r0
+}
+
+// MockHasConflictingNestedImports_Z_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Z'
+type MockHasConflictingNestedImports_Z_Call struct {
+	*mock.Call
+}
+
+// Z is a helper method to define mock.On call
+func (_e *MockHasConflictingNestedImports_Expecter) Z() *MockHasConflictingNestedImports_Z_Call {
+	return &MockHasConflictingNestedImports_Z_Call{Call: _e.mock.On("Z")}
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Run(run func()) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Return(myStruct http0.MyStruct) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Return(myStruct)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) RunAndReturn(run func() http0.MyStruct) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Once() *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Twice() *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Times(i int) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Maybe() *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) After(d time.Duration) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) WaitUntil(w <-chan time.Time) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) NotBefore(calls ...*mock.Call) *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockHasConflictingNestedImports_Z_Call) Unset() *MockHasConflictingNestedImports_Z_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockImportsSameAsPackage creates a new instance of MockImportsSameAsPackage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImportsSameAsPackage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImportsSameAsPackage {
+	mock := &MockImportsSameAsPackage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockImportsSameAsPackage is an autogenerated mock type for the ImportsSameAsPackage type
+type MockImportsSameAsPackage struct {
+	mock.Mock
+}
+
+type MockImportsSameAsPackage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImportsSameAsPackage) EXPECT() *MockImportsSameAsPackage_Expecter {
+	return &MockImportsSameAsPackage_Expecter{mock: &_m.Mock}
+}
+
+// A provides a mock function for the type MockImportsSameAsPackage
+func (_mock *MockImportsSameAsPackage) A() test.B {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for A")
+	}
+
+	var r0 test.B
+	if returnFunc, ok := ret.Get(0).(func() test.B); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(test.B)
+	}
+	return r0
+}
+
+// MockImportsSameAsPackage_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
+type MockImportsSameAsPackage_A_Call struct {
+	*mock.Call
+}
+
+// A is a helper method to define mock.On call
+func (_e *MockImportsSameAsPackage_Expecter) A() *MockImportsSameAsPackage_A_Call {
+	return &MockImportsSameAsPackage_A_Call{Call: _e.mock.On("A")}
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Run(run func()) *MockImportsSameAsPackage_A_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Return(b test.B) *MockImportsSameAsPackage_A_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) RunAndReturn(run func() test.B) *MockImportsSameAsPackage_A_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Once() *MockImportsSameAsPackage_A_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Twice() *MockImportsSameAsPackage_A_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Times(i int) *MockImportsSameAsPackage_A_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Maybe() *MockImportsSameAsPackage_A_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) After(d time.Duration) *MockImportsSameAsPackage_A_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) WaitUntil(w <-chan time.Time) *MockImportsSameAsPackage_A_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) NotBefore(calls ...*mock.Call) *MockImportsSameAsPackage_A_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_A_Call) Unset() *MockImportsSameAsPackage_A_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// B provides a mock function for the type MockImportsSameAsPackage
+func (_mock *MockImportsSameAsPackage) B() KeyManager {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for B")
+	}
+
+	var r0 KeyManager
+	if returnFunc, ok := ret.Get(0).(func() KeyManager); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(KeyManager)
+		}
+	}
+	return r0
+}
+
+// MockImportsSameAsPackage_B_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'B'
+type MockImportsSameAsPackage_B_Call struct {
+	*mock.Call
+}
+
+// B is a helper method to define mock.On call
+func (_e *MockImportsSameAsPackage_Expecter) B() *MockImportsSameAsPackage_B_Call {
+	return &MockImportsSameAsPackage_B_Call{Call: _e.mock.On("B")}
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Run(run func()) *MockImportsSameAsPackage_B_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Return(keyManager KeyManager) *MockImportsSameAsPackage_B_Call {
+	_c.Call.Return(keyManager)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) RunAndReturn(run func() KeyManager) *MockImportsSameAsPackage_B_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Once() *MockImportsSameAsPackage_B_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Twice() *MockImportsSameAsPackage_B_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Times(i int) *MockImportsSameAsPackage_B_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Maybe() *MockImportsSameAsPackage_B_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) After(d time.Duration) *MockImportsSameAsPackage_B_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) WaitUntil(w <-chan time.Time) *MockImportsSameAsPackage_B_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) NotBefore(calls ...*mock.Call) *MockImportsSameAsPackage_B_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_B_Call) Unset() *MockImportsSameAsPackage_B_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// C provides a mock function for the type MockImportsSameAsPackage
+func (_mock *MockImportsSameAsPackage) C(c C) {
+	_mock.Called(c)
+	return
+}
+
+// MockImportsSameAsPackage_C_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'C'
+type MockImportsSameAsPackage_C_Call struct {
+	*mock.Call
+}
+
+// C is a helper method to define mock.On call
+//   - c
+func (_e *MockImportsSameAsPackage_Expecter) C(c interface{}) *MockImportsSameAsPackage_C_Call {
+	return &MockImportsSameAsPackage_C_Call{Call: _e.mock.On("C", c)}
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Run(run func(c C)) *MockImportsSameAsPackage_C_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(C))
+	})
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Return() *MockImportsSameAsPackage_C_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) RunAndReturn(run func(c C)) *MockImportsSameAsPackage_C_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Once() *MockImportsSameAsPackage_C_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Twice() *MockImportsSameAsPackage_C_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Times(i int) *MockImportsSameAsPackage_C_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Maybe() *MockImportsSameAsPackage_C_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) After(d time.Duration) *MockImportsSameAsPackage_C_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) WaitUntil(w <-chan time.Time) *MockImportsSameAsPackage_C_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) NotBefore(calls ...*mock.Call) *MockImportsSameAsPackage_C_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackage_C_Call) Unset() *MockImportsSameAsPackage_C_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockImportsSameAsPackageZeroDefaults creates a new instance of MockImportsSameAsPackageZeroDefaults. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImportsSameAsPackageZeroDefaults(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImportsSameAsPackageZeroDefaults {
+	mock := &MockImportsSameAsPackageZeroDefaults{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockImportsSameAsPackageZeroDefaults is an autogenerated mock type for the ImportsSameAsPackage type
+type MockImportsSameAsPackageZeroDefaults struct {
+	mock.Mock
+	zeroMu    sync.Mutex
+	zeroCalls map[*mock.Call]bool
+}
+
+type MockImportsSameAsPackageZeroDefaults_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImportsSameAsPackageZeroDefaults) EXPECT() *MockImportsSameAsPackageZeroDefaults_Expecter {
+	return &MockImportsSameAsPackageZeroDefaults_Expecter{mock: &_m.Mock}
+}
+
+// _calledOrZero calls the mocked method. If no expectation matches the arguments,
+// or all the matching ones are used up, it first sets up a one-off expectation
+// returning rets, so that unexpected calls return zero values and are still
+// recorded.
+func (_mock *MockImportsSameAsPackageZeroDefaults) _calledOrZero(method string, rets []interface{}, args ...interface{}) mock.Arguments {
+	_mock.zeroMu.Lock()
+	matched := false
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.zeroCalls[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		if _mock.zeroCalls == nil {
+			_mock.zeroCalls = make(map[*mock.Call]bool)
+		}
+		_mock.zeroCalls[_mock.On(method, args...).Return(rets...).Once().Maybe()] = true
+	}
+	_mock.zeroMu.Unlock()
+
+	return _mock.MethodCalled(method, args...)
+}
+
+// A provides a mock function for the type MockImportsSameAsPackageZeroDefaults
+func (_mock *MockImportsSameAsPackageZeroDefaults) A() test.B {
+	ret := _mock._calledOrZero("A", []interface{}{test.B(0)})
+
+	if len(ret) == 0 {
+		panic("no return value specified for A")
+	}
+
+	var r0 test.B
+	if returnFunc, ok := ret.Get(0).(func() test.B); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(test.B)
+	}
+	return r0
+}
+
+// MockImportsSameAsPackageZeroDefaults_A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'A'
+type MockImportsSameAsPackageZeroDefaults_A_Call struct {
+	*mock.Call
+}
+
+// A is a helper method to define mock.On call
+func (_e *MockImportsSameAsPackageZeroDefaults_Expecter) A() *MockImportsSameAsPackageZeroDefaults_A_Call {
+	return &MockImportsSameAsPackageZeroDefaults_A_Call{Call: _e.mock.On("A")}
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Run(run func()) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Return(b test.B) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) RunAndReturn(run func() test.B) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Once() *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Twice() *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Times(i int) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Maybe() *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) After(d time.Duration) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) WaitUntil(w <-chan time.Time) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) NotBefore(calls ...*mock.Call) *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_A_Call) Unset() *MockImportsSameAsPackageZeroDefaults_A_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// B provides a mock function for the type MockImportsSameAsPackageZeroDefaults
+func (_mock *MockImportsSameAsPackageZeroDefaults) B() KeyManager {
+	ret := _mock._calledOrZero("B", []interface{}{nil})
+
+	if len(ret) == 0 {
+		panic("no return value specified for B")
+	}
+
+	var r0 KeyManager
+	if returnFunc, ok := ret.Get(0).(func() KeyManager); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(KeyManager)
+		}
+	}
+	return r0
+}
+
+// MockImportsSameAsPackageZeroDefaults_B_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'B'
+type MockImportsSameAsPackageZeroDefaults_B_Call struct {
+	*mock.Call
+}
+
+// B is a helper method to define mock.On call
+func (_e *MockImportsSameAsPackageZeroDefaults_Expecter) B() *MockImportsSameAsPackageZeroDefaults_B_Call {
+	return &MockImportsSameAsPackageZeroDefaults_B_Call{Call: _e.mock.On("B")}
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Run(run func()) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Return(keyManager KeyManager) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Return(keyManager)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) RunAndReturn(run func() KeyManager) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Once() *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Twice() *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Times(i int) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Maybe() *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) After(d time.Duration) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) WaitUntil(w <-chan time.Time) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) NotBefore(calls ...*mock.Call) *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_B_Call) Unset() *MockImportsSameAsPackageZeroDefaults_B_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// C provides a mock function for the type MockImportsSameAsPackageZeroDefaults
+func (_mock *MockImportsSameAsPackageZeroDefaults) C(c C) {
+	_mock._calledOrZero("C", []interface{}{}, c)
+	return
+}
+
+// MockImportsSameAsPackageZeroDefaults_C_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'C'
+type MockImportsSameAsPackageZeroDefaults_C_Call struct {
+	*mock.Call
+}
+
+// C is a helper method to define mock.On call
+//   - c
+func (_e *MockImportsSameAsPackageZeroDefaults_Expecter) C(c interface{}) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	return &MockImportsSameAsPackageZeroDefaults_C_Call{Call: _e.mock.On("C", c)}
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Run(run func(c C)) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(C))
+	})
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Return() *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) RunAndReturn(run func(c C)) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Once() *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Twice() *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Times(i int) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Maybe() *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) After(d time.Duration) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) WaitUntil(w <-chan time.Time) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) NotBefore(calls ...*mock.Call) *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockImportsSameAsPackageZeroDefaults_C_Call) Unset() *MockImportsSameAsPackageZeroDefaults_C_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockGenericInterface creates a new instance of MockGenericInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenericInterface[M any](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenericInterface[M] {
+	mock := &MockGenericInterface[M]{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenericInterface is an autogenerated mock type for the GenericInterface type
+type MockGenericInterface[M any] struct {
+	mock.Mock
+}
+
+type MockGenericInterface_Expecter[M any] struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenericInterface[M]) EXPECT() *MockGenericInterface_Expecter[M] {
+	return &MockGenericInterface_Expecter[M]{mock: &_m.Mock}
+}
+
+// Func provides a mock function for the type MockGenericInterface
+func (_mock *MockGenericInterface[M]) Func(arg *M) int {
+	ret := _mock.Called(arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Func")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func(*M) int); ok {
+		r0 = returnFunc(arg)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockGenericInterface_Func_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Func'
+type MockGenericInterface_Func_Call[M any] struct {
+	*mock.Call
+}
+
+// Func is a helper method to define mock.On call
+//   - arg
+func (_e *MockGenericInterface_Expecter[M]) Func(arg interface{}) *MockGenericInterface_Func_Call[M] {
+	return &MockGenericInterface_Func_Call[M]{Call: _e.mock.On("Func", arg)}
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Run(run func(arg *M)) *MockGenericInterface_Func_Call[M] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*M))
+	})
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Return(n int) *MockGenericInterface_Func_Call[M] {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) RunAndReturn(run func(arg *M) int) *MockGenericInterface_Func_Call[M] {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Once() *MockGenericInterface_Func_Call[M] {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Twice() *MockGenericInterface_Func_Call[M] {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Times(i int) *MockGenericInterface_Func_Call[M] {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Maybe() *MockGenericInterface_Func_Call[M] {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) After(d time.Duration) *MockGenericInterface_Func_Call[M] {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) WaitUntil(w <-chan time.Time) *MockGenericInterface_Func_Call[M] {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) NotBefore(calls ...*mock.Call) *MockGenericInterface_Func_Call[M] {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockGenericInterface_Func_Call[M]) Unset() *MockGenericInterface_Func_Call[M] {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockInstantiatedGenericInterface creates a new instance of MockInstantiatedGenericInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInstantiatedGenericInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInstantiatedGenericInterface {
+	mock := &MockInstantiatedGenericInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInstantiatedGenericInterface is an autogenerated mock type for the InstantiatedGenericInterface type
+type MockInstantiatedGenericInterface struct {
+	mock.Mock
+}
+
+type MockInstantiatedGenericInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInstantiatedGenericInterface) EXPECT() *MockInstantiatedGenericInterface_Expecter {
+	return &MockInstantiatedGenericInterface_Expecter{mock: &_m.Mock}
+}
+
+// Func provides a mock function for the type MockInstantiatedGenericInterface
+func (_mock *MockInstantiatedGenericInterface) Func(arg *float32) int {
+	ret := _mock.Called(arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Func")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func(*float32) int); ok {
+		r0 = returnFunc(arg)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockInstantiatedGenericInterface_Func_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Func'
+type MockInstantiatedGenericInterface_Func_Call struct {
+	*mock.Call
+}
+
+// Func is a helper method to define mock.On call
+//   - arg
+func (_e *MockInstantiatedGenericInterface_Expecter) Func(arg interface{}) *MockInstantiatedGenericInterface_Func_Call {
+	return &MockInstantiatedGenericInterface_Func_Call{Call: _e.mock.On("Func", arg)}
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Run(run func(arg *float32)) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*float32))
+	})
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Return(n int) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) RunAndReturn(run func(arg *float32) int) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Once() *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Twice() *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Times(i int) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Maybe() *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) After(d time.Duration) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) WaitUntil(w <-chan time.Time) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) NotBefore(calls ...*mock.Call) *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockInstantiatedGenericInterface_Func_Call) Unset() *MockInstantiatedGenericInterface_Func_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockMyReader creates a new instance of MockMyReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMyReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMyReader {
+	mock := &MockMyReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMyReader is an autogenerated mock type for the MyReader type
+type MockMyReader struct {
+	mock.Mock
+}
+
+type MockMyReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMyReader) EXPECT() *MockMyReader_Expecter {
+	return &MockMyReader_Expecter{mock: &_m.Mock}
+}
+
+// Read provides a mock function for the type MockMyReader
+func (_mock *MockMyReader) Read(p []byte) (int, error) {
+	ret := _mock.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte) (int, error)); ok {
+		return returnFunc(p)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte) int); ok {
+		r0 = returnFunc(p)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = returnFunc(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMyReader_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockMyReader_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - p
+func (_e *MockMyReader_Expecter) Read(p interface{}) *MockMyReader_Read_Call {
+	return &MockMyReader_Read_Call{Call: _e.mock.On("Read", p)}
+}
+
+func (_c *MockMyReader_Read_Call) Run(run func(p []byte)) *MockMyReader_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) Return(n int, err error) *MockMyReader_Read_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) RunAndReturn(run func(p []byte) (int, error)) *MockMyReader_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) Once() *MockMyReader_Read_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) Twice() *MockMyReader_Read_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) Times(i int) *MockMyReader_Read_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) Maybe() *MockMyReader_Read_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) After(d time.Duration) *MockMyReader_Read_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) WaitUntil(w <-chan time.Time) *MockMyReader_Read_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) NotBefore(calls ...*mock.Call) *MockMyReader_Read_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockMyReader_Read_Call) Unset() *MockMyReader_Read_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockIssue766 creates a new instance of MockIssue766. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssue766(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssue766 {
+	mock := &MockIssue766{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIssue766 is an autogenerated mock type for the Issue766 type
+type MockIssue766 struct {
+	mock.Mock
+}
+
+type MockIssue766_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssue766) EXPECT() *MockIssue766_Expecter {
+	return &MockIssue766_Expecter{mock: &_m.Mock}
+}
+
+// FetchData provides a mock function for the type MockIssue766
+func (_mock *MockIssue766) FetchData(fetchFunc func(x ...int) ([]int, error)) ([]int, error) {
+	ret := _mock.Called(fetchFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchData")
+	}
+
+	var r0 []int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(func(x ...int) ([]int, error)) ([]int, error)); ok {
+		return returnFunc(fetchFunc)
+	}
+	if returnFunc, ok := ret.Get(0).(func(func(x ...int) ([]int, error)) []int); ok {
+		r0 = returnFunc(fetchFunc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(func(x ...int) ([]int, error)) error); ok {
+		r1 = returnFunc(fetchFunc)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIssue766_FetchData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchData'
+type MockIssue766_FetchData_Call struct {
+	*mock.Call
+}
+
+// FetchData is a helper method to define mock.On call
+//   - fetchFunc
+func (_e *MockIssue766_Expecter) FetchData(fetchFunc interface{}) *MockIssue766_FetchData_Call {
+	return &MockIssue766_FetchData_Call{Call: _e.mock.On("FetchData", fetchFunc)}
+}
+
+func (_c *MockIssue766_FetchData_Call) Run(run func(fetchFunc func(x ...int) ([]int, error))) *MockIssue766_FetchData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(x ...int) ([]int, error)))
+	})
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) Return(ints []int, err error) *MockIssue766_FetchData_Call {
+	_c.Call.Return(ints, err)
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) RunAndReturn(run func(fetchFunc func(x ...int) ([]int, error)) ([]int, error)) *MockIssue766_FetchData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) Once() *MockIssue766_FetchData_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) Twice() *MockIssue766_FetchData_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) Times(i int) *MockIssue766_FetchData_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) Maybe() *MockIssue766_FetchData_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) After(d time.Duration) *MockIssue766_FetchData_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) WaitUntil(w <-chan time.Time) *MockIssue766_FetchData_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) NotBefore(calls ...*mock.Call) *MockIssue766_FetchData_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockIssue766_FetchData_Call) Unset() *MockIssue766_FetchData_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockMapToInterface creates a new instance of MockMapToInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapToInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapToInterface {
+	mock := &MockMapToInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapToInterface is an autogenerated mock type for the MapToInterface type
+type MockMapToInterface struct {
+	mock.Mock
+}
+
+type MockMapToInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapToInterface) EXPECT() *MockMapToInterface_Expecter {
+	return &MockMapToInterface_Expecter{mock: &_m.Mock}
+}
+
+// Foo provides a mock function for the type MockMapToInterface
+func (_mock *MockMapToInterface) Foo(arg1 ...map[string]interface{}) {
+	if len(arg1) > 0 {
+		_mock.Called(arg1)
+	} else {
+		_mock.Called()
+	}
+
+	return
+}
+
+// MockMapToInterface_Foo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Foo'
+type MockMapToInterface_Foo_Call struct {
+	*mock.Call
+}
+
+// Foo is a helper method to define mock.On call
+//   - arg1
+func (_e *MockMapToInterface_Expecter) Foo(arg1 ...interface{}) *MockMapToInterface_Foo_Call {
+	return &MockMapToInterface_Foo_Call{Call: _e.mock.On("Foo",
+		append([]interface{}{}, arg1...)...)}
+}
+
+func (_c *MockMapToInterface_Foo_Call) Run(run func(arg1 ...map[string]interface{})) *MockMapToInterface_Foo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) Return() *MockMapToInterface_Foo_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) RunAndReturn(run func(arg1 ...map[string]interface{})) *MockMapToInterface_Foo_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) Once() *MockMapToInterface_Foo_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) Twice() *MockMapToInterface_Foo_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) Times(i int) *MockMapToInterface_Foo_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) Maybe() *MockMapToInterface_Foo_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) After(d time.Duration) *MockMapToInterface_Foo_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) WaitUntil(w <-chan time.Time) *MockMapToInterface_Foo_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) NotBefore(calls ...*mock.Call) *MockMapToInterface_Foo_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockMapToInterface_Foo_Call) Unset() *MockMapToInterface_Foo_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockSibling creates a new instance of MockSibling. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSibling(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSibling {
+	mock := &MockSibling{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSibling is an autogenerated mock type for the Sibling type
+type MockSibling struct {
+	mock.Mock
+}
+
+type MockSibling_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSibling) EXPECT() *MockSibling_Expecter {
+	return &MockSibling_Expecter{mock: &_m.Mock}
+}
+
+// DoSomething provides a mock function for the type MockSibling
+func (_mock *MockSibling) DoSomething() {
+	_mock.Called()
+	return
+}
+
+// MockSibling_DoSomething_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoSomething'
+type MockSibling_DoSomething_Call struct {
+	*mock.Call
+}
+
+// DoSomething is a helper method to define mock.On call
+func (_e *MockSibling_Expecter) DoSomething() *MockSibling_DoSomething_Call {
+	return &MockSibling_DoSomething_Call{Call: _e.mock.On("DoSomething")}
+}
+
+func (_c *MockSibling_DoSomething_Call) Run(run func()) *MockSibling_DoSomething_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) Return() *MockSibling_DoSomething_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) RunAndReturn(run func()) *MockSibling_DoSomething_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) Once() *MockSibling_DoSomething_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) Twice() *MockSibling_DoSomething_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) Times(i int) *MockSibling_DoSomething_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) Maybe() *MockSibling_DoSomething_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) After(d time.Duration) *MockSibling_DoSomething_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) WaitUntil(w <-chan time.Time) *MockSibling_DoSomething_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) NotBefore(calls ...*mock.Call) *MockSibling_DoSomething_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockSibling_DoSomething_Call) Unset() *MockSibling_DoSomething_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockUsesOtherPkgIface creates a new instance of MockUsesOtherPkgIface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUsesOtherPkgIface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUsesOtherPkgIface {
+	mock := &MockUsesOtherPkgIface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUsesOtherPkgIface is an autogenerated mock type for the UsesOtherPkgIface type
+type MockUsesOtherPkgIface struct {
+	mock.Mock
+}
+
+type MockUsesOtherPkgIface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUsesOtherPkgIface) EXPECT() *MockUsesOtherPkgIface_Expecter {
+	return &MockUsesOtherPkgIface_Expecter{mock: &_m.Mock}
+}
+
+// DoSomethingElse provides a mock function for the type MockUsesOtherPkgIface
+func (_mock *MockUsesOtherPkgIface) DoSomethingElse(obj Sibling) {
+	_mock.Called(obj)
+	return
+}
+
+// MockUsesOtherPkgIface_DoSomethingElse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoSomethingElse'
+type MockUsesOtherPkgIface_DoSomethingElse_Call struct {
+	*mock.Call
+}
+
+// DoSomethingElse is a helper method to define mock.On call
+//   - obj
+func (_e *MockUsesOtherPkgIface_Expecter) DoSomethingElse(obj interface{}) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	return &MockUsesOtherPkgIface_DoSomethingElse_Call{Call: _e.mock.On("DoSomethingElse", obj)}
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Run(run func(obj Sibling)) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(Sibling))
+	})
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Return() *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) RunAndReturn(run func(obj Sibling)) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Once() *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Twice() *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Times(i int) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Maybe() *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) After(d time.Duration) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) WaitUntil(w <-chan time.Time) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) NotBefore(calls ...*mock.Call) *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockUsesOtherPkgIface_DoSomethingElse_Call) Unset() *MockUsesOtherPkgIface_DoSomethingElse_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockPanicOnNoReturnValue creates a new instance of MockPanicOnNoReturnValue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPanicOnNoReturnValue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPanicOnNoReturnValue {
+	mock := &MockPanicOnNoReturnValue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPanicOnNoReturnValue is an autogenerated mock type for the PanicOnNoReturnValue type
+type MockPanicOnNoReturnValue struct {
+	mock.Mock
+}
+
+type MockPanicOnNoReturnValue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPanicOnNoReturnValue) EXPECT() *MockPanicOnNoReturnValue_Expecter {
+	return &MockPanicOnNoReturnValue_Expecter{mock: &_m.Mock}
+}
+
+// DoSomething provides a mock function for the type MockPanicOnNoReturnValue
+func (_mock *MockPanicOnNoReturnValue) DoSomething() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoSomething")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockPanicOnNoReturnValue_DoSomething_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoSomething'
+type MockPanicOnNoReturnValue_DoSomething_Call struct {
+	*mock.Call
+}
+
+// DoSomething is a helper method to define mock.On call
+func (_e *MockPanicOnNoReturnValue_Expecter) DoSomething() *MockPanicOnNoReturnValue_DoSomething_Call {
+	return &MockPanicOnNoReturnValue_DoSomething_Call{Call: _e.mock.On("DoSomething")}
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Run(run func()) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Return(s string) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) RunAndReturn(run func() string) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Once() *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Twice() *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Times(i int) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Maybe() *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) After(d time.Duration) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) WaitUntil(w <-chan time.Time) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) NotBefore(calls ...*mock.Call) *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockPanicOnNoReturnValue_DoSomething_Call) Unset() *MockPanicOnNoReturnValue_DoSomething_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequester creates a new instance of MockRequester. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequester(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequester {
+	mock := &MockRequester{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequester is an autogenerated mock type for the Requester type
+type MockRequester struct {
+	mock.Mock
+}
+
+type MockRequester_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequester) EXPECT() *MockRequester_Expecter {
+	return &MockRequester_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequester
+func (_mock *MockRequester) Get(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequester_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequester_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequester_Expecter) Get(path interface{}) *MockRequester_Get_Call {
+	return &MockRequester_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequester_Get_Call) Run(run func(path string)) *MockRequester_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) Return(s string, err error) *MockRequester_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequester_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) Once() *MockRequester_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) Twice() *MockRequester_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) Times(i int) *MockRequester_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) Maybe() *MockRequester_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) After(d time.Duration) *MockRequester_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) WaitUntil(w <-chan time.Time) *MockRequester_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) NotBefore(calls ...*mock.Call) *MockRequester_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequester_Get_Call) Unset() *MockRequester_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterTypedArgs creates a new instance of MockRequesterTypedArgs. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterTypedArgs(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterTypedArgs {
+	mock := &MockRequesterTypedArgs{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterTypedArgs is an autogenerated mock type for the Requester type
+type MockRequesterTypedArgs struct {
+	mock.Mock
+}
+
+type MockRequesterTypedArgs_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterTypedArgs) EXPECT() *MockRequesterTypedArgs_Expecter {
+	return &MockRequesterTypedArgs_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterTypedArgs
+func (_mock *MockRequesterTypedArgs) Get(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterTypedArgs_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterTypedArgs_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterTypedArgs_Expecter) Get(path arg.Arg[string]) *MockRequesterTypedArgs_Get_Call {
+	return &MockRequesterTypedArgs_Get_Call{Call: _e.mock.On("Get", path.Matcher())}
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Run(run func(path string)) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Return(s string, err error) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Once() *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Twice() *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Times(i int) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Maybe() *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) After(d time.Duration) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterTypedArgs_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterTypedArgs_Get_Call) Unset() *MockRequesterTypedArgs_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterTypedCalls creates a new instance of MockRequesterTypedCalls. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterTypedCalls(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterTypedCalls {
+	mock := &MockRequesterTypedCalls{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterTypedCalls is an autogenerated mock type for the Requester type
+type MockRequesterTypedCalls struct {
+	mock.Mock
+	typedCallsMu sync.RWMutex
+	typedCalls   struct {
+		Get []MockRequesterTypedCalls_Get_CallArgs
+	}
+}
+
+type MockRequesterTypedCalls_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterTypedCalls) EXPECT() *MockRequesterTypedCalls_Expecter {
+	return &MockRequesterTypedCalls_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterTypedCalls
+func (_mock *MockRequesterTypedCalls) Get(path string) (string, error) {
+	ret := _mock.Called(path)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.Get = append(_mock.typedCalls.Get, MockRequesterTypedCalls_Get_CallArgs{
+		Path: path,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterTypedCalls_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterTypedCalls_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterTypedCalls_Expecter) Get(path interface{}) *MockRequesterTypedCalls_Get_Call {
+	return &MockRequesterTypedCalls_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Run(run func(path string)) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Return(s string, err error) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Once() *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Twice() *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Times(i int) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Maybe() *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) After(d time.Duration) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterTypedCalls_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterTypedCalls_Get_Call) Unset() *MockRequesterTypedCalls_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterTypedCalls_Get_CallArgs holds the arguments of a call to Get.
+type MockRequesterTypedCalls_Get_CallArgs struct {
+	Path string
+}
+
+// GetCalls returns the arguments of the calls made to Get, in order.
+func (_mock *MockRequesterTypedCalls) GetCalls() []MockRequesterTypedCalls_Get_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterTypedCalls_Get_CallArgs(nil), _mock.typedCalls.Get...)
+}
+
+// AssertGetCalled asserts that Get was called with the given arguments.
+func (_mock *MockRequesterTypedCalls) AssertGetCalled(t mock.TestingT, path string) bool {
+	return _mock.Mock.AssertCalled(t, "Get", path)
+}
+
+// AssertGetNotCalled asserts that Get was not called with the given arguments.
+func (_mock *MockRequesterTypedCalls) AssertGetNotCalled(t mock.TestingT, path string) bool {
+	return _mock.Mock.AssertNotCalled(t, "Get", path)
+}
+
+// NewMockRequesterZeroDefaults creates a new instance of MockRequesterZeroDefaults. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterZeroDefaults(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterZeroDefaults {
+	mock := &MockRequesterZeroDefaults{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterZeroDefaults is an autogenerated mock type for the Requester type
+type MockRequesterZeroDefaults struct {
+	mock.Mock
+	zeroMu    sync.Mutex
+	zeroCalls map[*mock.Call]bool
+}
+
+type MockRequesterZeroDefaults_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterZeroDefaults) EXPECT() *MockRequesterZeroDefaults_Expecter {
+	return &MockRequesterZeroDefaults_Expecter{mock: &_m.Mock}
+}
+
+// _calledOrZero calls the mocked method. If no expectation matches the arguments,
+// or all the matching ones are used up, it first sets up a one-off expectation
+// returning rets, so that unexpected calls return zero values and are still
+// recorded.
+func (_mock *MockRequesterZeroDefaults) _calledOrZero(method string, rets []interface{}, args ...interface{}) mock.Arguments {
+	_mock.zeroMu.Lock()
+	matched := false
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.zeroCalls[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		if _mock.zeroCalls == nil {
+			_mock.zeroCalls = make(map[*mock.Call]bool)
+		}
+		_mock.zeroCalls[_mock.On(method, args...).Return(rets...).Once().Maybe()] = true
+	}
+	_mock.zeroMu.Unlock()
+
+	return _mock.MethodCalled(method, args...)
+}
+
+// Get provides a mock function for the type MockRequesterZeroDefaults
+func (_mock *MockRequesterZeroDefaults) Get(path string) (string, error) {
+	ret := _mock._calledOrZero("Get", []interface{}{"", nil}, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterZeroDefaults_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterZeroDefaults_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterZeroDefaults_Expecter) Get(path interface{}) *MockRequesterZeroDefaults_Get_Call {
+	return &MockRequesterZeroDefaults_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Run(run func(path string)) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Return(s string, err error) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Once() *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Twice() *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Times(i int) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Maybe() *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) After(d time.Duration) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterZeroDefaults_Get_Call) Unset() *MockRequesterZeroDefaults_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterWaitHelpers creates a new instance of MockRequesterWaitHelpers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterWaitHelpers(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterWaitHelpers {
+	mock := &MockRequesterWaitHelpers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterWaitHelpers is an autogenerated mock type for the Requester type
+type MockRequesterWaitHelpers struct {
+	mock.Mock
+	awaitCalls await.Calls
+}
+
+type MockRequesterWaitHelpers_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterWaitHelpers) EXPECT() *MockRequesterWaitHelpers_Expecter {
+	return &MockRequesterWaitHelpers_Expecter{mock: &_m.Mock}
+}
+
+// WaitForExpectations waits until all the expectations of the mock are met, and
+// reports whether they were before ctx was done.
+func (_mock *MockRequesterWaitHelpers) WaitForExpectations(ctx context.Context) bool {
+	return _mock.awaitCalls.Expectations(ctx, &_mock.Mock)
+}
+
+// Get provides a mock function for the type MockRequesterWaitHelpers
+func (_mock *MockRequesterWaitHelpers) Get(path string) (string, error) {
+	defer _mock.awaitCalls.Record("Get")
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterWaitHelpers_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterWaitHelpers_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterWaitHelpers_Expecter) Get(path interface{}) *MockRequesterWaitHelpers_Get_Call {
+	return &MockRequesterWaitHelpers_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Run(run func(path string)) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Return(s string, err error) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Once() *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Twice() *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Times(i int) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Maybe() *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) After(d time.Duration) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterWaitHelpers_Get_Call) Unset() *MockRequesterWaitHelpers_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Notify sends on ch every time a call matches this expectation, after the
+// function set by Run, if any. Notify must be called after Run.
+func (_c *MockRequesterWaitHelpers_Get_Call) Notify(ch chan<- struct{}) *MockRequesterWaitHelpers_Get_Call {
+	run := _c.Call.RunFn
+	_c.Call.Run(func(args mock.Arguments) {
+		if run != nil {
+			run(args)
+		}
+		ch <- struct{}{}
+	})
+	return _c
+}
+
+// WaitForGet waits up to timeout for a call to Get, and reports
+// whether one was made.
+func (_mock *MockRequesterWaitHelpers) WaitForGet(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return _mock.awaitCalls.Called(ctx, "Get")
+}
+
+// NewMockRequesterPartial creates a new instance of MockRequesterPartial. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterPartial(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterPartial {
+	mock := &MockRequesterPartial{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// NewMockRequesterPartialWithDelegate creates a new instance of MockRequesterPartial like NewMockRequesterPartial.
+// Calls that match no expectation are forwarded to delegate, and still recorded.
+func NewMockRequesterPartialWithDelegate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}, delegate Requester) *MockRequesterPartial {
+	mock := NewMockRequesterPartial(t)
+	mock.delegate = delegate
+	return mock
+}
+
+// MockRequesterPartial is an autogenerated mock type for the Requester type
+type MockRequesterPartial struct {
+	mock.Mock
+	delegate   Requester
+	delegateMu sync.Mutex
+	delegated  map[*mock.Call]bool
+}
+
+type MockRequesterPartial_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterPartial) EXPECT() *MockRequesterPartial_Expecter {
+	return &MockRequesterPartial_Expecter{mock: &_m.Mock}
+}
+
+// _delegateCall reports whether a call should be forwarded to the delegate, which
+// is the case when there is one and no expectation matches the arguments, or all
+// the matching ones are used up. The
+// forwarded call is recorded through a one-off expectation, so that assertions
+// on the calls of the mock still see it.
+func (_mock *MockRequesterPartial) _delegateCall(method string, args ...interface{}) bool {
+	if _mock.delegate == nil {
+		return false
+	}
+	_mock.delegateMu.Lock()
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.delegated[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			_mock.delegateMu.Unlock()
+			return false
+		}
+	}
+	anything := make([]interface{}, len(args))
+	for i := range anything {
+		anything[i] = mock.Anything
+	}
+	if _mock.delegated == nil {
+		_mock.delegated = make(map[*mock.Call]bool)
+	}
+	_mock.delegated[_mock.On(method, anything...).Once().Maybe()] = true
+	_mock.delegateMu.Unlock()
+
+	_mock.MethodCalled(method, args...)
+	return true
+}
+
+// Get provides a mock function for the type MockRequesterPartial
+func (_mock *MockRequesterPartial) Get(path string) (string, error) {
+	if _mock._delegateCall("Get", path) {
+		return _mock.delegate.Get(path)
+	}
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterPartial_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterPartial_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterPartial_Expecter) Get(path interface{}) *MockRequesterPartial_Get_Call {
+	return &MockRequesterPartial_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterPartial_Get_Call) Run(run func(path string)) *MockRequesterPartial_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) Return(s string, err error) *MockRequesterPartial_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequesterPartial_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) Once() *MockRequesterPartial_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) Twice() *MockRequesterPartial_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) Times(i int) *MockRequesterPartial_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) Maybe() *MockRequesterPartial_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) After(d time.Duration) *MockRequesterPartial_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterPartial_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterPartial_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterPartial_Get_Call) Unset() *MockRequesterPartial_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterInOrder creates a new instance of MockRequesterInOrder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterInOrder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterInOrder {
+	mock := &MockRequesterInOrder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterInOrder is an autogenerated mock type for the Requester type
+type MockRequesterInOrder struct {
+	mock.Mock
+	seq *sequence.Sequence
+}
+
+type MockRequesterInOrder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterInOrder) EXPECT() *MockRequesterInOrder_Expecter {
+	return &MockRequesterInOrder_Expecter{mock: &_m.Mock}
+}
+
+// InSequence makes the mock record its calls in seq, which may be shared with
+// other mocks. See sequence.Sequence.AssertOrder.
+func (_mock *MockRequesterInOrder) InSequence(seq *sequence.Sequence) {
+	_mock.seq = seq
+}
+
+// Get provides a mock function for the type MockRequesterInOrder
+func (_mock *MockRequesterInOrder) Get(path string) (string, error) {
+	_mock.seq.Record(&_mock.Mock, "Get", path)
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterInOrder_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterInOrder_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterInOrder_Expecter) Get(path interface{}) *MockRequesterInOrder_Get_Call {
+	return &MockRequesterInOrder_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Run(run func(path string)) *MockRequesterInOrder_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Return(s string, err error) *MockRequesterInOrder_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) RunAndReturn(run func(path string) (string, error)) *MockRequesterInOrder_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Once() *MockRequesterInOrder_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Twice() *MockRequesterInOrder_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Times(i int) *MockRequesterInOrder_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Maybe() *MockRequesterInOrder_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) After(d time.Duration) *MockRequesterInOrder_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterInOrder_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterInOrder_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterInOrder_Get_Call) Unset() *MockRequesterInOrder_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockRequesterInOrder_Get_Call) MockCall() *mock.Call {
+	return _c.Call
+}
+
+// NewMockRequester2 creates a new instance of MockRequester2. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequester2(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequester2 {
+	mock := &MockRequester2{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequester2 is an autogenerated mock type for the Requester2 type
+type MockRequester2 struct {
+	mock.Mock
+}
+
+type MockRequester2_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequester2) EXPECT() *MockRequester2_Expecter {
+	return &MockRequester2_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequester2
+func (_mock *MockRequester2) Get(path string) error {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRequester2_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequester2_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequester2_Expecter) Get(path interface{}) *MockRequester2_Get_Call {
+	return &MockRequester2_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequester2_Get_Call) Run(run func(path string)) *MockRequester2_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) Return(err error) *MockRequester2_Get_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) RunAndReturn(run func(path string) error) *MockRequester2_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) Once() *MockRequester2_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) Twice() *MockRequester2_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) Times(i int) *MockRequester2_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) Maybe() *MockRequester2_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) After(d time.Duration) *MockRequester2_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) WaitUntil(w <-chan time.Time) *MockRequester2_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) NotBefore(calls ...*mock.Call) *MockRequester2_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequester2_Get_Call) Unset() *MockRequester2_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequester3 creates a new instance of MockRequester3. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequester3(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequester3 {
+	mock := &MockRequester3{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequester3 is an autogenerated mock type for the Requester3 type
+type MockRequester3 struct {
+	mock.Mock
+}
+
+type MockRequester3_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequester3) EXPECT() *MockRequester3_Expecter {
+	return &MockRequester3_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequester3
+func (_mock *MockRequester3) Get() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRequester3_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequester3_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockRequester3_Expecter) Get() *MockRequester3_Get_Call {
+	return &MockRequester3_Get_Call{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockRequester3_Get_Call) Run(run func()) *MockRequester3_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) Return(err error) *MockRequester3_Get_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) RunAndReturn(run func() error) *MockRequester3_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) Once() *MockRequester3_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) Twice() *MockRequester3_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) Times(i int) *MockRequester3_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) Maybe() *MockRequester3_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) After(d time.Duration) *MockRequester3_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) WaitUntil(w <-chan time.Time) *MockRequester3_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) NotBefore(calls ...*mock.Call) *MockRequester3_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequester3_Get_Call) Unset() *MockRequester3_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequester4 creates a new instance of MockRequester4. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequester4(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequester4 {
+	mock := &MockRequester4{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequester4 is an autogenerated mock type for the Requester4 type
+type MockRequester4 struct {
+	mock.Mock
+}
+
+type MockRequester4_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequester4) EXPECT() *MockRequester4_Expecter {
+	return &MockRequester4_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequester4
+func (_mock *MockRequester4) Get() {
+	_mock.Called()
+	return
+}
+
+// MockRequester4_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequester4_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockRequester4_Expecter) Get() *MockRequester4_Get_Call {
+	return &MockRequester4_Get_Call{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockRequester4_Get_Call) Run(run func()) *MockRequester4_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) Return() *MockRequester4_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) RunAndReturn(run func()) *MockRequester4_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) Once() *MockRequester4_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) Twice() *MockRequester4_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) Times(i int) *MockRequester4_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) Maybe() *MockRequester4_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) After(d time.Duration) *MockRequester4_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) WaitUntil(w <-chan time.Time) *MockRequester4_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) NotBefore(calls ...*mock.Call) *MockRequester4_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequester4_Get_Call) Unset() *MockRequester4_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterArgSameAsImport creates a new instance of MockRequesterArgSameAsImport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterArgSameAsImport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterArgSameAsImport {
+	mock := &MockRequesterArgSameAsImport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterArgSameAsImport is an autogenerated mock type for the RequesterArgSameAsImport type
+type MockRequesterArgSameAsImport struct {
+	mock.Mock
+}
+
+type MockRequesterArgSameAsImport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterArgSameAsImport) EXPECT() *MockRequesterArgSameAsImport_Expecter {
+	return &MockRequesterArgSameAsImport_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterArgSameAsImport
+func (_mock *MockRequesterArgSameAsImport) Get(json1 string) *json.RawMessage {
+	ret := _mock.Called(json1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *json.RawMessage
+	if returnFunc, ok := ret.Get(0).(func(string) *json.RawMessage); ok {
+		r0 = returnFunc(json1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*json.RawMessage)
+		}
+	}
+	return r0
+}
+
+// MockRequesterArgSameAsImport_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterArgSameAsImport_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - json1
+func (_e *MockRequesterArgSameAsImport_Expecter) Get(json1 interface{}) *MockRequesterArgSameAsImport_Get_Call {
+	return &MockRequesterArgSameAsImport_Get_Call{Call: _e.mock.On("Get", json1)}
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Run(run func(json1 string)) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Return(rawMessage *json.RawMessage) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Return(rawMessage)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) RunAndReturn(run func(json1 string) *json.RawMessage) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Once() *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Twice() *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Times(i int) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Maybe() *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) After(d time.Duration) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsImport_Get_Call) Unset() *MockRequesterArgSameAsImport_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterArgSameAsNamedImport creates a new instance of MockRequesterArgSameAsNamedImport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterArgSameAsNamedImport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterArgSameAsNamedImport {
+	mock := &MockRequesterArgSameAsNamedImport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterArgSameAsNamedImport is an autogenerated mock type for the RequesterArgSameAsNamedImport type
+type MockRequesterArgSameAsNamedImport struct {
+	mock.Mock
+}
+
+type MockRequesterArgSameAsNamedImport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterArgSameAsNamedImport) EXPECT() *MockRequesterArgSameAsNamedImport_Expecter {
+	return &MockRequesterArgSameAsNamedImport_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterArgSameAsNamedImport
+func (_mock *MockRequesterArgSameAsNamedImport) Get(json1 string) *json.RawMessage {
+	ret := _mock.Called(json1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *json.RawMessage
+	if returnFunc, ok := ret.Get(0).(func(string) *json.RawMessage); ok {
+		r0 = returnFunc(json1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*json.RawMessage)
+		}
+	}
+	return r0
+}
+
+// MockRequesterArgSameAsNamedImport_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterArgSameAsNamedImport_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - json1
+func (_e *MockRequesterArgSameAsNamedImport_Expecter) Get(json1 interface{}) *MockRequesterArgSameAsNamedImport_Get_Call {
+	return &MockRequesterArgSameAsNamedImport_Get_Call{Call: _e.mock.On("Get", json1)}
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Run(run func(json1 string)) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Return(rawMessage *json.RawMessage) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Return(rawMessage)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) RunAndReturn(run func(json1 string) *json.RawMessage) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Once() *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Twice() *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Times(i int) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Maybe() *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) After(d time.Duration) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsNamedImport_Get_Call) Unset() *MockRequesterArgSameAsNamedImport_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterArgSameAsPkg creates a new instance of MockRequesterArgSameAsPkg. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterArgSameAsPkg(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterArgSameAsPkg {
+	mock := &MockRequesterArgSameAsPkg{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterArgSameAsPkg is an autogenerated mock type for the RequesterArgSameAsPkg type
+type MockRequesterArgSameAsPkg struct {
+	mock.Mock
+}
+
+type MockRequesterArgSameAsPkg_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterArgSameAsPkg) EXPECT() *MockRequesterArgSameAsPkg_Expecter {
+	return &MockRequesterArgSameAsPkg_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterArgSameAsPkg
+func (_mock *MockRequesterArgSameAsPkg) Get(test1 string) {
+	_mock.Called(test1)
+	return
+}
+
+// MockRequesterArgSameAsPkg_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterArgSameAsPkg_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - test1
+func (_e *MockRequesterArgSameAsPkg_Expecter) Get(test1 interface{}) *MockRequesterArgSameAsPkg_Get_Call {
+	return &MockRequesterArgSameAsPkg_Get_Call{Call: _e.mock.On("Get", test1)}
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Run(run func(test1 string)) *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Return() *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) RunAndReturn(run func(test1 string)) *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Once() *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Twice() *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Times(i int) *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Maybe() *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) After(d time.Duration) *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterArgSameAsPkg_Get_Call) Unset() *MockRequesterArgSameAsPkg_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterArray creates a new instance of MockRequesterArray. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterArray(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterArray {
+	mock := &MockRequesterArray{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterArray is an autogenerated mock type for the RequesterArray type
+type MockRequesterArray struct {
+	mock.Mock
+}
+
+type MockRequesterArray_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterArray) EXPECT() *MockRequesterArray_Expecter {
+	return &MockRequesterArray_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterArray
+func (_mock *MockRequesterArray) Get(path string) ([2]string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 [2]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([2]string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) [2]string); ok {
+		r0 = returnFunc(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([2]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterArray_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterArray_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterArray_Expecter) Get(path interface{}) *MockRequesterArray_Get_Call {
+	return &MockRequesterArray_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterArray_Get_Call) Run(run func(path string)) *MockRequesterArray_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) Return(strings [2]string, err error) *MockRequesterArray_Get_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) RunAndReturn(run func(path string) ([2]string, error)) *MockRequesterArray_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) Once() *MockRequesterArray_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) Twice() *MockRequesterArray_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) Times(i int) *MockRequesterArray_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) Maybe() *MockRequesterArray_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) After(d time.Duration) *MockRequesterArray_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterArray_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterArray_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterArray_Get_Call) Unset() *MockRequesterArray_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterElided creates a new instance of MockRequesterElided. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterElided(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterElided {
+	mock := &MockRequesterElided{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterElided is an autogenerated mock type for the RequesterElided type
+type MockRequesterElided struct {
+	mock.Mock
+}
+
+type MockRequesterElided_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterElided) EXPECT() *MockRequesterElided_Expecter {
+	return &MockRequesterElided_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterElided
+func (_mock *MockRequesterElided) Get(path string, url string) error {
+	ret := _mock.Called(path, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(path, url)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRequesterElided_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterElided_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+//   - url
+func (_e *MockRequesterElided_Expecter) Get(path interface{}, url interface{}) *MockRequesterElided_Get_Call {
+	return &MockRequesterElided_Get_Call{Call: _e.mock.On("Get", path, url)}
+}
+
+func (_c *MockRequesterElided_Get_Call) Run(run func(path string, url string)) *MockRequesterElided_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) Return(err error) *MockRequesterElided_Get_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) RunAndReturn(run func(path string, url string) error) *MockRequesterElided_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) Once() *MockRequesterElided_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) Twice() *MockRequesterElided_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) Times(i int) *MockRequesterElided_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) Maybe() *MockRequesterElided_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) After(d time.Duration) *MockRequesterElided_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterElided_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterElided_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterElided_Get_Call) Unset() *MockRequesterElided_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterIface creates a new instance of MockRequesterIface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterIface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterIface {
+	mock := &MockRequesterIface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterIface is an autogenerated mock type for the RequesterIface type
+type MockRequesterIface struct {
+	mock.Mock
+}
+
+type MockRequesterIface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterIface) EXPECT() *MockRequesterIface_Expecter {
+	return &MockRequesterIface_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterIface
+func (_mock *MockRequesterIface) Get() io.Reader {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 io.Reader
+	if returnFunc, ok := ret.Get(0).(func() io.Reader); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+	return r0
+}
+
+// MockRequesterIface_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterIface_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockRequesterIface_Expecter) Get() *MockRequesterIface_Get_Call {
+	return &MockRequesterIface_Get_Call{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockRequesterIface_Get_Call) Run(run func()) *MockRequesterIface_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) Return(reader io.Reader) *MockRequesterIface_Get_Call {
+	_c.Call.Return(reader)
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) RunAndReturn(run func() io.Reader) *MockRequesterIface_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) Once() *MockRequesterIface_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) Twice() *MockRequesterIface_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) Times(i int) *MockRequesterIface_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) Maybe() *MockRequesterIface_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) After(d time.Duration) *MockRequesterIface_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterIface_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterIface_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterIface_Get_Call) Unset() *MockRequesterIface_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterNS creates a new instance of MockRequesterNS. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterNS(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterNS {
+	mock := &MockRequesterNS{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterNS is an autogenerated mock type for the RequesterNS type
+type MockRequesterNS struct {
+	mock.Mock
+}
+
+type MockRequesterNS_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterNS) EXPECT() *MockRequesterNS_Expecter {
+	return &MockRequesterNS_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterNS
+func (_mock *MockRequesterNS) Get(path string) (http.Response, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 http.Response
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (http.Response, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) http.Response); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(http.Response)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterNS_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterNS_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterNS_Expecter) Get(path interface{}) *MockRequesterNS_Get_Call {
+	return &MockRequesterNS_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterNS_Get_Call) Run(run func(path string)) *MockRequesterNS_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) Return(response http.Response, err error) *MockRequesterNS_Get_Call {
+	_c.Call.Return(response, err)
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) RunAndReturn(run func(path string) (http.Response, error)) *MockRequesterNS_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) Once() *MockRequesterNS_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) Twice() *MockRequesterNS_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) Times(i int) *MockRequesterNS_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) Maybe() *MockRequesterNS_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) After(d time.Duration) *MockRequesterNS_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterNS_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterNS_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterNS_Get_Call) Unset() *MockRequesterNS_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterPtr creates a new instance of MockRequesterPtr. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterPtr(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterPtr {
+	mock := &MockRequesterPtr{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterPtr is an autogenerated mock type for the RequesterPtr type
+type MockRequesterPtr struct {
+	mock.Mock
+}
+
+type MockRequesterPtr_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterPtr) EXPECT() *MockRequesterPtr_Expecter {
+	return &MockRequesterPtr_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterPtr
+func (_mock *MockRequesterPtr) Get(path string) (*string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *string); ok {
+		r0 = returnFunc(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterPtr_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterPtr_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterPtr_Expecter) Get(path interface{}) *MockRequesterPtr_Get_Call {
+	return &MockRequesterPtr_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterPtr_Get_Call) Run(run func(path string)) *MockRequesterPtr_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) Return(s *string, err error) *MockRequesterPtr_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) RunAndReturn(run func(path string) (*string, error)) *MockRequesterPtr_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) Once() *MockRequesterPtr_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) Twice() *MockRequesterPtr_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) Times(i int) *MockRequesterPtr_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) Maybe() *MockRequesterPtr_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) After(d time.Duration) *MockRequesterPtr_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterPtr_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterPtr_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterPtr_Get_Call) Unset() *MockRequesterPtr_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterReturnElided creates a new instance of MockRequesterReturnElided. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterReturnElided(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterReturnElided {
+	mock := &MockRequesterReturnElided{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterReturnElided is an autogenerated mock type for the RequesterReturnElided type
+type MockRequesterReturnElided struct {
+	mock.Mock
+}
+
+type MockRequesterReturnElided_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterReturnElided) EXPECT() *MockRequesterReturnElided_Expecter {
+	return &MockRequesterReturnElided_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterReturnElided
+func (_mock *MockRequesterReturnElided) Get(path string) (int, int, int, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, int, int, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) int); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) int); ok {
+		r2 = returnFunc(path)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+	if returnFunc, ok := ret.Get(3).(func(string) error); ok {
+		r3 = returnFunc(path)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockRequesterReturnElided_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterReturnElided_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterReturnElided_Expecter) Get(path interface{}) *MockRequesterReturnElided_Get_Call {
+	return &MockRequesterReturnElided_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Run(run func(path string)) *MockRequesterReturnElided_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Return(a int, b int, c int, err error) *MockRequesterReturnElided_Get_Call {
+	_c.Call.Return(a, b, c, err)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) RunAndReturn(run func(path string) (int, int, int, error)) *MockRequesterReturnElided_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Once() *MockRequesterReturnElided_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Twice() *MockRequesterReturnElided_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Times(i int) *MockRequesterReturnElided_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Maybe() *MockRequesterReturnElided_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) After(d time.Duration) *MockRequesterReturnElided_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterReturnElided_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterReturnElided_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Get_Call) Unset() *MockRequesterReturnElided_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Put provides a mock function for the type MockRequesterReturnElided
+func (_mock *MockRequesterReturnElided) Put(path string) (int, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterReturnElided_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockRequesterReturnElided_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterReturnElided_Expecter) Put(path interface{}) *MockRequesterReturnElided_Put_Call {
+	return &MockRequesterReturnElided_Put_Call{Call: _e.mock.On("Put", path)}
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Run(run func(path string)) *MockRequesterReturnElided_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Return(n int, err error) *MockRequesterReturnElided_Put_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) RunAndReturn(run func(path string) (int, error)) *MockRequesterReturnElided_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Once() *MockRequesterReturnElided_Put_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Twice() *MockRequesterReturnElided_Put_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Times(i int) *MockRequesterReturnElided_Put_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Maybe() *MockRequesterReturnElided_Put_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) After(d time.Duration) *MockRequesterReturnElided_Put_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) WaitUntil(w <-chan time.Time) *MockRequesterReturnElided_Put_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) NotBefore(calls ...*mock.Call) *MockRequesterReturnElided_Put_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterReturnElided_Put_Call) Unset() *MockRequesterReturnElided_Put_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterSlice creates a new instance of MockRequesterSlice. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterSlice(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterSlice {
+	mock := &MockRequesterSlice{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterSlice is an autogenerated mock type for the RequesterSlice type
+type MockRequesterSlice struct {
+	mock.Mock
+}
+
+type MockRequesterSlice_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterSlice) EXPECT() *MockRequesterSlice_Expecter {
+	return &MockRequesterSlice_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterSlice
+func (_mock *MockRequesterSlice) Get(path string) ([]string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRequesterSlice_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterSlice_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - path
+func (_e *MockRequesterSlice_Expecter) Get(path interface{}) *MockRequesterSlice_Get_Call {
+	return &MockRequesterSlice_Get_Call{Call: _e.mock.On("Get", path)}
+}
+
+func (_c *MockRequesterSlice_Get_Call) Run(run func(path string)) *MockRequesterSlice_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) Return(strings []string, err error) *MockRequesterSlice_Get_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) RunAndReturn(run func(path string) ([]string, error)) *MockRequesterSlice_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) Once() *MockRequesterSlice_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) Twice() *MockRequesterSlice_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) Times(i int) *MockRequesterSlice_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) Maybe() *MockRequesterSlice_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) After(d time.Duration) *MockRequesterSlice_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterSlice_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterSlice_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterSlice_Get_Call) Unset() *MockRequesterSlice_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// newMockrequesterUnexported creates a new instance of mockrequesterUnexported. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockrequesterUnexported(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockrequesterUnexported {
+	mock := &mockrequesterUnexported{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockrequesterUnexported is an autogenerated mock type for the requesterUnexported type
+type mockrequesterUnexported struct {
+	mock.Mock
+}
+
+type mockrequesterUnexported_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockrequesterUnexported) EXPECT() *mockrequesterUnexported_Expecter {
+	return &mockrequesterUnexported_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type mockrequesterUnexported
+func (_mock *mockrequesterUnexported) Get() {
+	_mock.Called()
+	return
+}
+
+// mockrequesterUnexported_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type mockrequesterUnexported_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *mockrequesterUnexported_Expecter) Get() *mockrequesterUnexported_Get_Call {
+	return &mockrequesterUnexported_Get_Call{Call: _e.mock.On("Get")}
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Run(run func()) *mockrequesterUnexported_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Return() *mockrequesterUnexported_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) RunAndReturn(run func()) *mockrequesterUnexported_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Once() *mockrequesterUnexported_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Twice() *mockrequesterUnexported_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Times(i int) *mockrequesterUnexported_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Maybe() *mockrequesterUnexported_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) After(d time.Duration) *mockrequesterUnexported_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) WaitUntil(w <-chan time.Time) *mockrequesterUnexported_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) NotBefore(calls ...*mock.Call) *mockrequesterUnexported_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockrequesterUnexported_Get_Call) Unset() *mockrequesterUnexported_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicOneArgument creates a new instance of MockRequesterVariadicOneArgument. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicOneArgument(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicOneArgument {
+	mock := &MockRequesterVariadicOneArgument{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicOneArgument is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicOneArgument struct {
+	mock.Mock
+}
+
+type MockRequesterVariadicOneArgument_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicOneArgument) EXPECT() *MockRequesterVariadicOneArgument_Expecter {
+	return &MockRequesterVariadicOneArgument_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterVariadicOneArgument
+func (_mock *MockRequesterVariadicOneArgument) Get(values ...string) bool {
+	var tmpRet mock.Arguments
+	if len(values) > 0 {
+		tmpRet = _mock.Called(values)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgument_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicOneArgument_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicOneArgument_Expecter) Get(values ...interface{}) *MockRequesterVariadicOneArgument_Get_Call {
+	return &MockRequesterVariadicOneArgument_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Return(b bool) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Once() *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Twice() *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Times(i int) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Maybe() *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) After(d time.Duration) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Get_Call) Unset() *MockRequesterVariadicOneArgument_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicOneArgument
+func (_mock *MockRequesterVariadicOneArgument) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var tmpRet mock.Arguments
+	if len(w) > 0 {
+		tmpRet = _mock.Called(filename, w)
+	} else {
+		tmpRet = _mock.Called(filename)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgument_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicOneArgument_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicOneArgument_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	return &MockRequesterVariadicOneArgument_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Once() *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Twice() *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Unset() *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicOneArgument
+func (_mock *MockRequesterVariadicOneArgument) OneInterface(a ...interface{}) bool {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(a)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgument_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicOneArgument_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicOneArgument_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	return &MockRequesterVariadicOneArgument_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Return(b bool) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Once() *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Twice() *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Times(i int) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Maybe() *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Unset() *MockRequesterVariadicOneArgument_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicOneArgument
+func (_mock *MockRequesterVariadicOneArgument) Sprintf(format string, a ...interface{}) string {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(format, a)
+	} else {
+		tmpRet = _mock.Called(format)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgument_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicOneArgument_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicOneArgument_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	return &MockRequesterVariadicOneArgument_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Return(s string) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Once() *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Twice() *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Times(i int) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Maybe() *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Unset() *MockRequesterVariadicOneArgument_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadic creates a new instance of MockRequesterVariadic. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadic(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadic {
+	mock := &MockRequesterVariadic{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadic is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadic struct {
+	mock.Mock
+}
+
+type MockRequesterVariadic_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadic) EXPECT() *MockRequesterVariadic_Expecter {
+	return &MockRequesterVariadic_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterVariadic
+func (_mock *MockRequesterVariadic) Get(values ...string) bool {
+	// string
+	_va := make([]interface{}, len(values))
+	for _i := range values {
+		_va[_i] = values[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadic_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadic_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadic_Expecter) Get(values ...interface{}) *MockRequesterVariadic_Get_Call {
+	return &MockRequesterVariadic_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Run(run func(values ...string)) *MockRequesterVariadic_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Return(b bool) *MockRequesterVariadic_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadic_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Once() *MockRequesterVariadic_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Twice() *MockRequesterVariadic_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Times(i int) *MockRequesterVariadic_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Maybe() *MockRequesterVariadic_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) After(d time.Duration) *MockRequesterVariadic_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadic_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadic_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Get_Call) Unset() *MockRequesterVariadic_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadic
+func (_mock *MockRequesterVariadic) MultiWriteToFile(filename string, w ...io.Writer) string {
+	// io.Writer
+	_va := make([]interface{}, len(w))
+	for _i := range w {
+		_va[_i] = w[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadic_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadic_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadic_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadic_MultiWriteToFile_Call {
+	return &MockRequesterVariadic_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Once() *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Twice() *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Maybe() *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Unset() *MockRequesterVariadic_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadic
+func (_mock *MockRequesterVariadic) OneInterface(a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadic_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadic_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadic_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadic_OneInterface_Call {
+	return &MockRequesterVariadic_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Return(b bool) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Once() *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Twice() *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Times(i int) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Maybe() *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) After(d time.Duration) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_OneInterface_Call) Unset() *MockRequesterVariadic_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadic
+func (_mock *MockRequesterVariadic) Sprintf(format string, a ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadic_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadic_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadic_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadic_Sprintf_Call {
+	return &MockRequesterVariadic_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Return(s string) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Once() *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Twice() *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Times(i int) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Maybe() *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) After(d time.Duration) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadic_Sprintf_Call) Unset() *MockRequesterVariadic_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicOneArgumentTypedArgs creates a new instance of MockRequesterVariadicOneArgumentTypedArgs. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicOneArgumentTypedArgs(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicOneArgumentTypedArgs {
+	mock := &MockRequesterVariadicOneArgumentTypedArgs{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicOneArgumentTypedArgs is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicOneArgumentTypedArgs struct {
+	mock.Mock
+}
+
+type MockRequesterVariadicOneArgumentTypedArgs_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicOneArgumentTypedArgs) EXPECT() *MockRequesterVariadicOneArgumentTypedArgs_Expecter {
+	return &MockRequesterVariadicOneArgumentTypedArgs_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterVariadicOneArgumentTypedArgs
+func (_mock *MockRequesterVariadicOneArgumentTypedArgs) Get(values ...string) bool {
+	var tmpRet mock.Arguments
+	if len(values) > 0 {
+		tmpRet = _mock.Called(values)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedArgs_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicOneArgumentTypedArgs_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicOneArgumentTypedArgs_Expecter) Get(values ...arg.Arg[[]string]) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_ca := []interface{}{}
+	for _, _a := range values {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicOneArgumentTypedArgs_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Return(b bool) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Once() *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Twice() *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Maybe() *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Get_Call) Unset() *MockRequesterVariadicOneArgumentTypedArgs_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicOneArgumentTypedArgs
+func (_mock *MockRequesterVariadicOneArgumentTypedArgs) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var tmpRet mock.Arguments
+	if len(w) > 0 {
+		tmpRet = _mock.Called(filename, w)
+	} else {
+		tmpRet = _mock.Called(filename)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicOneArgumentTypedArgs_Expecter) MultiWriteToFile(filename arg.Arg[string], w ...arg.Arg[[]io.Writer]) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_ca := []interface{}{filename.Matcher()}
+	for _, _a := range w {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile", _ca...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Once() *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Twice() *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call) Unset() *MockRequesterVariadicOneArgumentTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicOneArgumentTypedArgs
+func (_mock *MockRequesterVariadicOneArgumentTypedArgs) OneInterface(a ...interface{}) bool {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(a)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicOneArgumentTypedArgs_Expecter) OneInterface(a ...arg.Arg[[]interface{}]) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_ca := []interface{}{}
+	for _, _a := range a {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call{Call: _e.mock.On("OneInterface", _ca...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Return(b bool) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Once() *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Twice() *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Maybe() *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call) Unset() *MockRequesterVariadicOneArgumentTypedArgs_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicOneArgumentTypedArgs
+func (_mock *MockRequesterVariadicOneArgumentTypedArgs) Sprintf(format string, a ...interface{}) string {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(format, a)
+	} else {
+		tmpRet = _mock.Called(format)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicOneArgumentTypedArgs_Expecter) Sprintf(format arg.Arg[string], a ...arg.Arg[[]interface{}]) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_ca := []interface{}{format.Matcher()}
+	for _, _a := range a {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call{Call: _e.mock.On("Sprintf", _ca...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Return(s string) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Once() *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Twice() *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Maybe() *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call) Unset() *MockRequesterVariadicOneArgumentTypedArgs_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicTypedArgs creates a new instance of MockRequesterVariadicTypedArgs. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicTypedArgs(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicTypedArgs {
+	mock := &MockRequesterVariadicTypedArgs{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicTypedArgs is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicTypedArgs struct {
+	mock.Mock
+}
+
+type MockRequesterVariadicTypedArgs_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicTypedArgs) EXPECT() *MockRequesterVariadicTypedArgs_Expecter {
+	return &MockRequesterVariadicTypedArgs_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterVariadicTypedArgs
+func (_mock *MockRequesterVariadicTypedArgs) Get(values ...string) bool {
+	// string
+	_va := make([]interface{}, len(values))
+	for _i := range values {
+		_va[_i] = values[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedArgs_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicTypedArgs_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicTypedArgs_Expecter) Get(values ...arg.Arg[string]) *MockRequesterVariadicTypedArgs_Get_Call {
+	_ca := []interface{}{}
+	for _, _a := range values {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicTypedArgs_Get_Call{Call: _e.mock.On("Get", _ca...)}
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Return(b bool) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Once() *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Twice() *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Times(i int) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Maybe() *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) After(d time.Duration) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Get_Call) Unset() *MockRequesterVariadicTypedArgs_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicTypedArgs
+func (_mock *MockRequesterVariadicTypedArgs) MultiWriteToFile(filename string, w ...io.Writer) string {
+	// io.Writer
+	_va := make([]interface{}, len(w))
+	for _i := range w {
+		_va[_i] = w[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedArgs_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicTypedArgs_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicTypedArgs_Expecter) MultiWriteToFile(filename arg.Arg[string], w ...arg.Arg[io.Writer]) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_ca := []interface{}{filename.Matcher()}
+	for _, _a := range w {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicTypedArgs_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile", _ca...)}
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Once() *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Twice() *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call) Unset() *MockRequesterVariadicTypedArgs_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicTypedArgs
+func (_mock *MockRequesterVariadicTypedArgs) OneInterface(a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedArgs_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicTypedArgs_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicTypedArgs_Expecter) OneInterface(a ...arg.Arg[interface{}]) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_ca := []interface{}{}
+	for _, _a := range a {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicTypedArgs_OneInterface_Call{Call: _e.mock.On("OneInterface", _ca...)}
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Return(b bool) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Once() *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Twice() *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Times(i int) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Maybe() *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_OneInterface_Call) Unset() *MockRequesterVariadicTypedArgs_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicTypedArgs
+func (_mock *MockRequesterVariadicTypedArgs) Sprintf(format string, a ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedArgs_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicTypedArgs_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicTypedArgs_Expecter) Sprintf(format arg.Arg[string], a ...arg.Arg[interface{}]) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_ca := []interface{}{format.Matcher()}
+	for _, _a := range a {
+		_ca = append(_ca, _a.Matcher())
+	}
+	return &MockRequesterVariadicTypedArgs_Sprintf_Call{Call: _e.mock.On("Sprintf", _ca...)}
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Return(s string) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Once() *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Twice() *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Times(i int) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Maybe() *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedArgs_Sprintf_Call) Unset() *MockRequesterVariadicTypedArgs_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicOneArgumentTypedCalls creates a new instance of MockRequesterVariadicOneArgumentTypedCalls. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicOneArgumentTypedCalls(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicOneArgumentTypedCalls {
+	mock := &MockRequesterVariadicOneArgumentTypedCalls{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicOneArgumentTypedCalls struct {
+	mock.Mock
+	typedCallsMu sync.RWMutex
+	typedCalls   struct {
+		Get              []MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs
+		MultiWriteToFile []MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_CallArgs
+		OneInterface     []MockRequesterVariadicOneArgumentTypedCalls_OneInterface_CallArgs
+		Sprintf          []MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs
+	}
+}
+
+type MockRequesterVariadicOneArgumentTypedCalls_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicOneArgumentTypedCalls) EXPECT() *MockRequesterVariadicOneArgumentTypedCalls_Expecter {
+	return &MockRequesterVariadicOneArgumentTypedCalls_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterVariadicOneArgumentTypedCalls
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) Get(values ...string) bool {
+	var tmpRet mock.Arguments
+	if len(values) > 0 {
+		tmpRet = _mock.Called(values)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.Get = append(_mock.typedCalls.Get, MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs{
+		Values: values,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicOneArgumentTypedCalls_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicOneArgumentTypedCalls_Expecter) Get(values ...interface{}) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	return &MockRequesterVariadicOneArgumentTypedCalls_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Return(b bool) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Once() *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Twice() *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Maybe() *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Get_Call) Unset() *MockRequesterVariadicOneArgumentTypedCalls_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs holds the arguments of a call to Get.
+type MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs struct {
+	Values []string
+}
+
+// GetCalls returns the arguments of the calls made to Get, in order.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) GetCalls() []MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs(nil), _mock.typedCalls.Get...)
+}
+
+// AssertGetCalled asserts that Get was called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertGetCalled(t mock.TestingT, values ...string) bool {
+	var _ca []interface{}
+	if len(values) > 0 {
+		_ca = append(_ca, values)
+	}
+	return _mock.Mock.AssertCalled(t, "Get", _ca...)
+}
+
+// AssertGetNotCalled asserts that Get was not called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertGetNotCalled(t mock.TestingT, values ...string) bool {
+	var _ca []interface{}
+	if len(values) > 0 {
+		_ca = append(_ca, values)
+	}
+	return _mock.Mock.AssertNotCalled(t, "Get", _ca...)
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicOneArgumentTypedCalls
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var tmpRet mock.Arguments
+	if len(w) > 0 {
+		tmpRet = _mock.Called(filename, w)
+	} else {
+		tmpRet = _mock.Called(filename)
+	}
+	ret := tmpRet
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.MultiWriteToFile = append(_mock.typedCalls.MultiWriteToFile, MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_CallArgs{
+		Filename: filename,
+		W:        w,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicOneArgumentTypedCalls_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	return &MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Once() *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Twice() *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call) Unset() *MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_CallArgs holds the arguments of a call to MultiWriteToFile.
+type MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_CallArgs struct {
+	Filename string
+	W        []io.Writer
+}
+
+// MultiWriteToFileCalls returns the arguments of the calls made to MultiWriteToFile, in order.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) MultiWriteToFileCalls() []MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicOneArgumentTypedCalls_MultiWriteToFile_CallArgs(nil), _mock.typedCalls.MultiWriteToFile...)
+}
+
+// AssertMultiWriteToFileCalled asserts that MultiWriteToFile was called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertMultiWriteToFileCalled(t mock.TestingT, filename string, w ...io.Writer) bool {
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	if len(w) > 0 {
+		_ca = append(_ca, w)
+	}
+	return _mock.Mock.AssertCalled(t, "MultiWriteToFile", _ca...)
+}
+
+// AssertMultiWriteToFileNotCalled asserts that MultiWriteToFile was not called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertMultiWriteToFileNotCalled(t mock.TestingT, filename string, w ...io.Writer) bool {
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	if len(w) > 0 {
+		_ca = append(_ca, w)
+	}
+	return _mock.Mock.AssertNotCalled(t, "MultiWriteToFile", _ca...)
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicOneArgumentTypedCalls
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) OneInterface(a ...interface{}) bool {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(a)
+	} else {
+		tmpRet = _mock.Called()
+	}
+	ret := tmpRet
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.OneInterface = append(_mock.typedCalls.OneInterface, MockRequesterVariadicOneArgumentTypedCalls_OneInterface_CallArgs{
+		A: a,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicOneArgumentTypedCalls_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	return &MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Return(b bool) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Once() *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Twice() *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Maybe() *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call) Unset() *MockRequesterVariadicOneArgumentTypedCalls_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_OneInterface_CallArgs holds the arguments of a call to OneInterface.
+type MockRequesterVariadicOneArgumentTypedCalls_OneInterface_CallArgs struct {
+	A []interface{}
+}
+
+// OneInterfaceCalls returns the arguments of the calls made to OneInterface, in order.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) OneInterfaceCalls() []MockRequesterVariadicOneArgumentTypedCalls_OneInterface_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicOneArgumentTypedCalls_OneInterface_CallArgs(nil), _mock.typedCalls.OneInterface...)
+}
+
+// AssertOneInterfaceCalled asserts that OneInterface was called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertOneInterfaceCalled(t mock.TestingT, a ...interface{}) bool {
+	var _ca []interface{}
+	if len(a) > 0 {
+		_ca = append(_ca, a)
+	}
+	return _mock.Mock.AssertCalled(t, "OneInterface", _ca...)
+}
+
+// AssertOneInterfaceNotCalled asserts that OneInterface was not called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertOneInterfaceNotCalled(t mock.TestingT, a ...interface{}) bool {
+	var _ca []interface{}
+	if len(a) > 0 {
+		_ca = append(_ca, a)
+	}
+	return _mock.Mock.AssertNotCalled(t, "OneInterface", _ca...)
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicOneArgumentTypedCalls
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) Sprintf(format string, a ...interface{}) string {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(format, a)
+	} else {
+		tmpRet = _mock.Called(format)
+	}
+	ret := tmpRet
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.Sprintf = append(_mock.typedCalls.Sprintf, MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs{
+		Format: format,
+		A:      a,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicOneArgumentTypedCalls_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	return &MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Return(s string) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Once() *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Twice() *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Times(i int) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Maybe() *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call) Unset() *MockRequesterVariadicOneArgumentTypedCalls_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs holds the arguments of a call to Sprintf.
+type MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs struct {
+	Format string
+	A      []interface{}
+}
+
+// SprintfCalls returns the arguments of the calls made to Sprintf, in order.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) SprintfCalls() []MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs(nil), _mock.typedCalls.Sprintf...)
+}
+
+// AssertSprintfCalled asserts that Sprintf was called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertSprintfCalled(t mock.TestingT, format string, a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	if len(a) > 0 {
+		_ca = append(_ca, a)
+	}
+	return _mock.Mock.AssertCalled(t, "Sprintf", _ca...)
+}
+
+// AssertSprintfNotCalled asserts that Sprintf was not called with the given arguments.
+func (_mock *MockRequesterVariadicOneArgumentTypedCalls) AssertSprintfNotCalled(t mock.TestingT, format string, a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	if len(a) > 0 {
+		_ca = append(_ca, a)
+	}
+	return _mock.Mock.AssertNotCalled(t, "Sprintf", _ca...)
+}
+
+// NewMockRequesterVariadicTypedCalls creates a new instance of MockRequesterVariadicTypedCalls. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicTypedCalls(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicTypedCalls {
+	mock := &MockRequesterVariadicTypedCalls{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicTypedCalls is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicTypedCalls struct {
+	mock.Mock
+	typedCallsMu sync.RWMutex
+	typedCalls   struct {
+		Get              []MockRequesterVariadicTypedCalls_Get_CallArgs
+		MultiWriteToFile []MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs
+		OneInterface     []MockRequesterVariadicTypedCalls_OneInterface_CallArgs
+		Sprintf          []MockRequesterVariadicTypedCalls_Sprintf_CallArgs
+	}
+}
+
+type MockRequesterVariadicTypedCalls_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicTypedCalls) EXPECT() *MockRequesterVariadicTypedCalls_Expecter {
+	return &MockRequesterVariadicTypedCalls_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRequesterVariadicTypedCalls
+func (_mock *MockRequesterVariadicTypedCalls) Get(values ...string) bool {
+	// string
+	_va := make([]interface{}, len(values))
+	for _i := range values {
+		_va[_i] = values[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.Get = append(_mock.typedCalls.Get, MockRequesterVariadicTypedCalls_Get_CallArgs{
+		Values: values,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedCalls_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicTypedCalls_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicTypedCalls_Expecter) Get(values ...interface{}) *MockRequesterVariadicTypedCalls_Get_Call {
+	return &MockRequesterVariadicTypedCalls_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Return(b bool) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Once() *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Twice() *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Times(i int) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Maybe() *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) After(d time.Duration) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Get_Call) Unset() *MockRequesterVariadicTypedCalls_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicTypedCalls_Get_CallArgs holds the arguments of a call to Get.
+type MockRequesterVariadicTypedCalls_Get_CallArgs struct {
+	Values []string
+}
+
+// GetCalls returns the arguments of the calls made to Get, in order.
+func (_mock *MockRequesterVariadicTypedCalls) GetCalls() []MockRequesterVariadicTypedCalls_Get_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicTypedCalls_Get_CallArgs(nil), _mock.typedCalls.Get...)
+}
+
+// AssertGetCalled asserts that Get was called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertGetCalled(t mock.TestingT, values ...string) bool {
+	var _ca []interface{}
+	for _, _a := range values {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertCalled(t, "Get", _ca...)
+}
+
+// AssertGetNotCalled asserts that Get was not called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertGetNotCalled(t mock.TestingT, values ...string) bool {
+	var _ca []interface{}
+	for _, _a := range values {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertNotCalled(t, "Get", _ca...)
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicTypedCalls
+func (_mock *MockRequesterVariadicTypedCalls) MultiWriteToFile(filename string, w ...io.Writer) string {
+	// io.Writer
+	_va := make([]interface{}, len(w))
+	for _i := range w {
+		_va[_i] = w[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.MultiWriteToFile = append(_mock.typedCalls.MultiWriteToFile, MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs{
+		Filename: filename,
+		W:        w,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedCalls_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicTypedCalls_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicTypedCalls_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	return &MockRequesterVariadicTypedCalls_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Once() *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Twice() *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call) Unset() *MockRequesterVariadicTypedCalls_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs holds the arguments of a call to MultiWriteToFile.
+type MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs struct {
+	Filename string
+	W        []io.Writer
+}
+
+// MultiWriteToFileCalls returns the arguments of the calls made to MultiWriteToFile, in order.
+func (_mock *MockRequesterVariadicTypedCalls) MultiWriteToFileCalls() []MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs(nil), _mock.typedCalls.MultiWriteToFile...)
+}
+
+// AssertMultiWriteToFileCalled asserts that MultiWriteToFile was called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertMultiWriteToFileCalled(t mock.TestingT, filename string, w ...io.Writer) bool {
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	for _, _a := range w {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertCalled(t, "MultiWriteToFile", _ca...)
+}
+
+// AssertMultiWriteToFileNotCalled asserts that MultiWriteToFile was not called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertMultiWriteToFileNotCalled(t mock.TestingT, filename string, w ...io.Writer) bool {
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	for _, _a := range w {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertNotCalled(t, "MultiWriteToFile", _ca...)
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicTypedCalls
+func (_mock *MockRequesterVariadicTypedCalls) OneInterface(a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.OneInterface = append(_mock.typedCalls.OneInterface, MockRequesterVariadicTypedCalls_OneInterface_CallArgs{
+		A: a,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedCalls_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicTypedCalls_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicTypedCalls_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	return &MockRequesterVariadicTypedCalls_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Return(b bool) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Once() *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Twice() *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Times(i int) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Maybe() *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_OneInterface_Call) Unset() *MockRequesterVariadicTypedCalls_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicTypedCalls_OneInterface_CallArgs holds the arguments of a call to OneInterface.
+type MockRequesterVariadicTypedCalls_OneInterface_CallArgs struct {
+	A []interface{}
+}
+
+// OneInterfaceCalls returns the arguments of the calls made to OneInterface, in order.
+func (_mock *MockRequesterVariadicTypedCalls) OneInterfaceCalls() []MockRequesterVariadicTypedCalls_OneInterface_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicTypedCalls_OneInterface_CallArgs(nil), _mock.typedCalls.OneInterface...)
+}
+
+// AssertOneInterfaceCalled asserts that OneInterface was called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertOneInterfaceCalled(t mock.TestingT, a ...interface{}) bool {
+	var _ca []interface{}
+	for _, _a := range a {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertCalled(t, "OneInterface", _ca...)
+}
+
+// AssertOneInterfaceNotCalled asserts that OneInterface was not called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertOneInterfaceNotCalled(t mock.TestingT, a ...interface{}) bool {
+	var _ca []interface{}
+	for _, _a := range a {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertNotCalled(t, "OneInterface", _ca...)
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicTypedCalls
+func (_mock *MockRequesterVariadicTypedCalls) Sprintf(format string, a ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
+	_mock.typedCallsMu.Lock()
+	_mock.typedCalls.Sprintf = append(_mock.typedCalls.Sprintf, MockRequesterVariadicTypedCalls_Sprintf_CallArgs{
+		Format: format,
+		A:      a,
+	})
+	_mock.typedCallsMu.Unlock()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicTypedCalls_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicTypedCalls_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicTypedCalls_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	return &MockRequesterVariadicTypedCalls_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Return(s string) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Once() *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Twice() *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Times(i int) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Maybe() *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicTypedCalls_Sprintf_Call) Unset() *MockRequesterVariadicTypedCalls_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockRequesterVariadicTypedCalls_Sprintf_CallArgs holds the arguments of a call to Sprintf.
+type MockRequesterVariadicTypedCalls_Sprintf_CallArgs struct {
+	Format string
+	A      []interface{}
+}
+
+// SprintfCalls returns the arguments of the calls made to Sprintf, in order.
+func (_mock *MockRequesterVariadicTypedCalls) SprintfCalls() []MockRequesterVariadicTypedCalls_Sprintf_CallArgs {
+	_mock.typedCallsMu.RLock()
+	defer _mock.typedCallsMu.RUnlock()
+	return append([]MockRequesterVariadicTypedCalls_Sprintf_CallArgs(nil), _mock.typedCalls.Sprintf...)
+}
+
+// AssertSprintfCalled asserts that Sprintf was called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertSprintfCalled(t mock.TestingT, format string, a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	for _, _a := range a {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertCalled(t, "Sprintf", _ca...)
+}
+
+// AssertSprintfNotCalled asserts that Sprintf was not called with the given arguments.
+func (_mock *MockRequesterVariadicTypedCalls) AssertSprintfNotCalled(t mock.TestingT, format string, a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	for _, _a := range a {
+		_ca = append(_ca, _a)
+	}
+	return _mock.Mock.AssertNotCalled(t, "Sprintf", _ca...)
+}
+
+// NewMockRequesterVariadicOneArgumentZeroDefaults creates a new instance of MockRequesterVariadicOneArgumentZeroDefaults. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicOneArgumentZeroDefaults(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicOneArgumentZeroDefaults {
+	mock := &MockRequesterVariadicOneArgumentZeroDefaults{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicOneArgumentZeroDefaults is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicOneArgumentZeroDefaults struct {
+	mock.Mock
+	zeroMu    sync.Mutex
+	zeroCalls map[*mock.Call]bool
+}
+
+type MockRequesterVariadicOneArgumentZeroDefaults_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicOneArgumentZeroDefaults) EXPECT() *MockRequesterVariadicOneArgumentZeroDefaults_Expecter {
+	return &MockRequesterVariadicOneArgumentZeroDefaults_Expecter{mock: &_m.Mock}
+}
+
+// _calledOrZero calls the mocked method. If no expectation matches the arguments,
+// or all the matching ones are used up, it first sets up a one-off expectation
+// returning rets, so that unexpected calls return zero values and are still
+// recorded.
+func (_mock *MockRequesterVariadicOneArgumentZeroDefaults) _calledOrZero(method string, rets []interface{}, args ...interface{}) mock.Arguments {
+	_mock.zeroMu.Lock()
+	matched := false
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.zeroCalls[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		if _mock.zeroCalls == nil {
+			_mock.zeroCalls = make(map[*mock.Call]bool)
+		}
+		_mock.zeroCalls[_mock.On(method, args...).Return(rets...).Once().Maybe()] = true
+	}
+	_mock.zeroMu.Unlock()
+
+	return _mock.MethodCalled(method, args...)
+}
+
+// Get provides a mock function for the type MockRequesterVariadicOneArgumentZeroDefaults
+func (_mock *MockRequesterVariadicOneArgumentZeroDefaults) Get(values ...string) bool {
+	var tmpRet mock.Arguments
+	if len(values) > 0 {
+		tmpRet = _mock._calledOrZero("Get", []interface{}{false}, values)
+	} else {
+		tmpRet = _mock._calledOrZero("Get", []interface{}{false})
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentZeroDefaults_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicOneArgumentZeroDefaults_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicOneArgumentZeroDefaults_Expecter) Get(values ...interface{}) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	return &MockRequesterVariadicOneArgumentZeroDefaults_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Return(b bool) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Once() *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Twice() *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Times(i int) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Maybe() *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call) Unset() *MockRequesterVariadicOneArgumentZeroDefaults_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicOneArgumentZeroDefaults
+func (_mock *MockRequesterVariadicOneArgumentZeroDefaults) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var tmpRet mock.Arguments
+	if len(w) > 0 {
+		tmpRet = _mock._calledOrZero("MultiWriteToFile", []interface{}{""}, filename, w)
+	} else {
+		tmpRet = _mock._calledOrZero("MultiWriteToFile", []interface{}{""}, filename)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicOneArgumentZeroDefaults_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	return &MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Once() *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Twice() *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call) Unset() *MockRequesterVariadicOneArgumentZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicOneArgumentZeroDefaults
+func (_mock *MockRequesterVariadicOneArgumentZeroDefaults) OneInterface(a ...interface{}) bool {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock._calledOrZero("OneInterface", []interface{}{false}, a)
+	} else {
+		tmpRet = _mock._calledOrZero("OneInterface", []interface{}{false})
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicOneArgumentZeroDefaults_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	return &MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Return(b bool) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Once() *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Twice() *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Times(i int) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Maybe() *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call) Unset() *MockRequesterVariadicOneArgumentZeroDefaults_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicOneArgumentZeroDefaults
+func (_mock *MockRequesterVariadicOneArgumentZeroDefaults) Sprintf(format string, a ...interface{}) string {
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock._calledOrZero("Sprintf", []interface{}{""}, format, a)
+	} else {
+		tmpRet = _mock._calledOrZero("Sprintf", []interface{}{""}, format)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicOneArgumentZeroDefaults_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	return &MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Return(s string) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Once() *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Twice() *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Times(i int) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Maybe() *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call) Unset() *MockRequesterVariadicOneArgumentZeroDefaults_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicZeroDefaults creates a new instance of MockRequesterVariadicZeroDefaults. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicZeroDefaults(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicZeroDefaults {
+	mock := &MockRequesterVariadicZeroDefaults{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequesterVariadicZeroDefaults is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicZeroDefaults struct {
+	mock.Mock
+	zeroMu    sync.Mutex
+	zeroCalls map[*mock.Call]bool
+}
+
+type MockRequesterVariadicZeroDefaults_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicZeroDefaults) EXPECT() *MockRequesterVariadicZeroDefaults_Expecter {
+	return &MockRequesterVariadicZeroDefaults_Expecter{mock: &_m.Mock}
+}
+
+// _calledOrZero calls the mocked method. If no expectation matches the arguments,
+// or all the matching ones are used up, it first sets up a one-off expectation
+// returning rets, so that unexpected calls return zero values and are still
+// recorded.
+func (_mock *MockRequesterVariadicZeroDefaults) _calledOrZero(method string, rets []interface{}, args ...interface{}) mock.Arguments {
+	_mock.zeroMu.Lock()
+	matched := false
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.zeroCalls[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		if _mock.zeroCalls == nil {
+			_mock.zeroCalls = make(map[*mock.Call]bool)
+		}
+		_mock.zeroCalls[_mock.On(method, args...).Return(rets...).Once().Maybe()] = true
+	}
+	_mock.zeroMu.Unlock()
+
+	return _mock.MethodCalled(method, args...)
+}
+
+// Get provides a mock function for the type MockRequesterVariadicZeroDefaults
+func (_mock *MockRequesterVariadicZeroDefaults) Get(values ...string) bool {
+	// string
+	_va := make([]interface{}, len(values))
+	for _i := range values {
+		_va[_i] = values[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _mock._calledOrZero("Get", []interface{}{false}, _ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicZeroDefaults_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicZeroDefaults_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicZeroDefaults_Expecter) Get(values ...interface{}) *MockRequesterVariadicZeroDefaults_Get_Call {
+	return &MockRequesterVariadicZeroDefaults_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Return(b bool) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Once() *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Twice() *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Times(i int) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Maybe() *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) After(d time.Duration) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Get_Call) Unset() *MockRequesterVariadicZeroDefaults_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicZeroDefaults
+func (_mock *MockRequesterVariadicZeroDefaults) MultiWriteToFile(filename string, w ...io.Writer) string {
+	// io.Writer
+	_va := make([]interface{}, len(w))
+	for _i := range w {
+		_va[_i] = w[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	_ca = append(_ca, _va...)
+	ret := _mock._calledOrZero("MultiWriteToFile", []interface{}{""}, _ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicZeroDefaults_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	return &MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Once() *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Twice() *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call) Unset() *MockRequesterVariadicZeroDefaults_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicZeroDefaults
+func (_mock *MockRequesterVariadicZeroDefaults) OneInterface(a ...interface{}) bool {
+	var _ca []interface{}
+	_ca = append(_ca, a...)
+	ret := _mock._calledOrZero("OneInterface", []interface{}{false}, _ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockRequesterVariadicZeroDefaults_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicZeroDefaults_OneInterface_Call struct {
+	*mock.Call
+}
+
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicZeroDefaults_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	return &MockRequesterVariadicZeroDefaults_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Return(b bool) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Once() *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Twice() *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Times(i int) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Maybe() *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_OneInterface_Call) Unset() *MockRequesterVariadicZeroDefaults_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicZeroDefaults
+func (_mock *MockRequesterVariadicZeroDefaults) Sprintf(format string, a ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, a...)
+	ret := _mock._calledOrZero("Sprintf", []interface{}{""}, _ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) int); ok {
-		r1 = returnFunc(path)
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
 	} else {
-		r1 = ret.Get(1).(int)
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(2).(func(string) int); ok {
-		r2 = returnFunc(path)
+	return r0
+}
+
+// MockRequesterVariadicZeroDefaults_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicZeroDefaults_Sprintf_Call struct {
+	*mock.Call
+}
+
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicZeroDefaults_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	return &MockRequesterVariadicZeroDefaults_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Return(s string) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Once() *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Twice() *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Times(i int) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Maybe() *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicZeroDefaults_Sprintf_Call) Unset() *MockRequesterVariadicZeroDefaults_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicOneArgumentPartial creates a new instance of MockRequesterVariadicOneArgumentPartial. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequesterVariadicOneArgumentPartial(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequesterVariadicOneArgumentPartial {
+	mock := &MockRequesterVariadicOneArgumentPartial{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// NewMockRequesterVariadicOneArgumentPartialWithDelegate creates a new instance of MockRequesterVariadicOneArgumentPartial like NewMockRequesterVariadicOneArgumentPartial.
+// Calls that match no expectation are forwarded to delegate, and still recorded.
+func NewMockRequesterVariadicOneArgumentPartialWithDelegate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}, delegate RequesterVariadic) *MockRequesterVariadicOneArgumentPartial {
+	mock := NewMockRequesterVariadicOneArgumentPartial(t)
+	mock.delegate = delegate
+	return mock
+}
+
+// MockRequesterVariadicOneArgumentPartial is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicOneArgumentPartial struct {
+	mock.Mock
+	delegate   RequesterVariadic
+	delegateMu sync.Mutex
+	delegated  map[*mock.Call]bool
+}
+
+type MockRequesterVariadicOneArgumentPartial_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequesterVariadicOneArgumentPartial) EXPECT() *MockRequesterVariadicOneArgumentPartial_Expecter {
+	return &MockRequesterVariadicOneArgumentPartial_Expecter{mock: &_m.Mock}
+}
+
+// _delegateCall reports whether a call should be forwarded to the delegate, which
+// is the case when there is one and no expectation matches the arguments, or all
+// the matching ones are used up. The
+// forwarded call is recorded through a one-off expectation, so that assertions
+// on the calls of the mock still see it.
+func (_mock *MockRequesterVariadicOneArgumentPartial) _delegateCall(method string, args ...interface{}) bool {
+	if _mock.delegate == nil {
+		return false
+	}
+	_mock.delegateMu.Lock()
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.delegated[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			_mock.delegateMu.Unlock()
+			return false
+		}
+	}
+	anything := make([]interface{}, len(args))
+	for i := range anything {
+		anything[i] = mock.Anything
+	}
+	if _mock.delegated == nil {
+		_mock.delegated = make(map[*mock.Call]bool)
+	}
+	_mock.delegated[_mock.On(method, anything...).Once().Maybe()] = true
+	_mock.delegateMu.Unlock()
+
+	_mock.MethodCalled(method, args...)
+	return true
+}
+
+// Get provides a mock function for the type MockRequesterVariadicOneArgumentPartial
+func (_mock *MockRequesterVariadicOneArgumentPartial) Get(values ...string) bool {
+	var _args []interface{}
+	if len(values) > 0 {
+		_args = append(_args, values)
+	}
+	if _mock._delegateCall("Get", _args...) {
+		return _mock.delegate.Get(values...)
+	}
+	var tmpRet mock.Arguments
+	if len(values) > 0 {
+		tmpRet = _mock.Called(values)
 	} else {
-		r2 = ret.Get(2).(int)
+		tmpRet = _mock.Called()
 	}
-	if returnFunc, ok := ret.Get(3).(func(string) error); ok {
-		r3 = returnFunc(path)
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...string) bool); ok {
+		r0 = returnFunc(values...)
 	} else {
-		r3 = ret.Error(3)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0, r1, r2, r3
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentPartial_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicOneArgumentPartial_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - values
+func (_e *MockRequesterVariadicOneArgumentPartial_Expecter) Get(values ...interface{}) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	return &MockRequesterVariadicOneArgumentPartial_Get_Call{Call: _e.mock.On("Get",
+		append([]interface{}{}, values...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Return(b bool) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Once() *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Twice() *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Times(i int) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Maybe() *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_Get_Call) Unset() *MockRequesterVariadicOneArgumentPartial_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicOneArgumentPartial
+func (_mock *MockRequesterVariadicOneArgumentPartial) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var _args []interface{}
+	_args = append(_args, filename)
+	if len(w) > 0 {
+		_args = append(_args, w)
+	}
+	if _mock._delegateCall("MultiWriteToFile", _args...) {
+		return _mock.delegate.MultiWriteToFile(filename, w...)
+	}
+	var tmpRet mock.Arguments
+	if len(w) > 0 {
+		tmpRet = _mock.Called(filename, w)
+	} else {
+		tmpRet = _mock.Called(filename)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultiWriteToFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...io.Writer) string); ok {
+		r0 = returnFunc(filename, w...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call struct {
+	*mock.Call
+}
+
+// MultiWriteToFile is a helper method to define mock.On call
+//   - filename
+//   - w
+func (_e *MockRequesterVariadicOneArgumentPartial_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	return &MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+		append([]interface{}{filename}, w...)...)}
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]io.Writer, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(io.Writer)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// MockRequesterReturnElided_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterReturnElided_Get_Call struct {
-	*mock.Call
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Once() *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequesterReturnElided_Expecter) Get(path interface{}) *MockRequesterReturnElided_Get_Call {
-	return &MockRequesterReturnElided_Get_Call{Call: _e.mock.On("Get", path)}
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Twice() *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-func (_c *MockRequesterReturnElided_Get_Call) Run(run func(path string)) *MockRequesterReturnElided_Get_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Times(i)
 	return _c
 }
 
-func (_c *MockRequesterReturnElided_Get_Call) Return(a int, b int, c int, err error) *MockRequesterReturnElided_Get_Call {
-	_c.Call.Return(a, b, c, err)
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Maybe()
 	return _c
 }
 
-func (_c *MockRequesterReturnElided_Get_Call) RunAndReturn(run func(path string) (int, int, int, error)) *MockRequesterReturnElided_Get_Call {
-	_c.Call.Return(run)
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.After(d)
 	return _c
 }
 
-// Put provides a mock function for the type MockRequesterReturnElided
-func (_mock *MockRequesterReturnElided) Put(path string) (int, error) {
-	ret := _mock.Called(path)
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for Put")
-	}
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
 
-	var r0 int
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
-		return returnFunc(path)
+func (_c *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call) Unset() *MockRequesterVariadicOneArgumentPartial_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicOneArgumentPartial
+func (_mock *MockRequesterVariadicOneArgumentPartial) OneInterface(a ...interface{}) bool {
+	var _args []interface{}
+	if len(a) > 0 {
+		_args = append(_args, a)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
-		r0 = returnFunc(path)
+	if _mock._delegateCall("OneInterface", _args...) {
+		return _mock.delegate.OneInterface(a...)
+	}
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(a)
 	} else {
-		r0 = ret.Get(0).(int)
+		tmpRet = _mock.Called()
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for OneInterface")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(...interface{}) bool); ok {
+		r0 = returnFunc(a...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockRequesterReturnElided_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
-type MockRequesterReturnElided_Put_Call struct {
+// MockRequesterVariadicOneArgumentPartial_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicOneArgumentPartial_OneInterface_Call struct {
 	*mock.Call
 }
 
-// Put is a helper method to define mock.On call
-//   - path
-func (_e *MockRequesterReturnElided_Expecter) Put(path interface{}) *MockRequesterReturnElided_Put_Call {
-	return &MockRequesterReturnElided_Put_Call{Call: _e.mock.On("Put", path)}
+// OneInterface is a helper method to define mock.On call
+//   - a
+func (_e *MockRequesterVariadicOneArgumentPartial_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	return &MockRequesterVariadicOneArgumentPartial_OneInterface_Call{Call: _e.mock.On("OneInterface",
+		append([]interface{}{}, a...)...)}
 }
 
-func (_c *MockRequesterReturnElided_Put_Call) Run(run func(path string)) *MockRequesterReturnElided_Put_Call {
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]interface{}, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRequesterReturnElided_Put_Call) Return(n int, err error) *MockRequesterReturnElided_Put_Call {
-	_c.Call.Return(n, err)
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Return(b bool) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockRequesterReturnElided_Put_Call) RunAndReturn(run func(path string) (int, error)) *MockRequesterReturnElided_Put_Call {
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterSlice creates a new instance of MockRequesterSlice. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRequesterSlice(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRequesterSlice {
-	mock := &MockRequesterSlice{}
-	mock.Mock.Test(t)
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Once() *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Twice() *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
 
-	return mock
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Times(i int) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// MockRequesterSlice is an autogenerated mock type for the RequesterSlice type
-type MockRequesterSlice struct {
-	mock.Mock
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Maybe() *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-type MockRequesterSlice_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_m *MockRequesterSlice) EXPECT() *MockRequesterSlice_Expecter {
-	return &MockRequesterSlice_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
 }
 
-// Get provides a mock function for the type MockRequesterSlice
-func (_mock *MockRequesterSlice) Get(path string) ([]string, error) {
-	ret := _mock.Called(path)
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
+func (_c *MockRequesterVariadicOneArgumentPartial_OneInterface_Call) Unset() *MockRequesterVariadicOneArgumentPartial_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
 
-	var r0 []string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
-		return returnFunc(path)
+// Sprintf provides a mock function for the type MockRequesterVariadicOneArgumentPartial
+func (_mock *MockRequesterVariadicOneArgumentPartial) Sprintf(format string, a ...interface{}) string {
+	var _args []interface{}
+	_args = append(_args, format)
+	if len(a) > 0 {
+		_args = append(_args, a)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = returnFunc(path)
+	if _mock._delegateCall("Sprintf", _args...) {
+		return _mock.delegate.Sprintf(format, a...)
+	}
+	var tmpRet mock.Arguments
+	if len(a) > 0 {
+		tmpRet = _mock.Called(format, a)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		tmpRet = _mock.Called(format)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sprintf")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = returnFunc(format, a...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(string)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockRequesterSlice_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterSlice_Get_Call struct {
+// MockRequesterVariadicOneArgumentPartial_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicOneArgumentPartial_Sprintf_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - path
-func (_e *MockRequesterSlice_Expecter) Get(path interface{}) *MockRequesterSlice_Get_Call {
-	return &MockRequesterSlice_Get_Call{Call: _e.mock.On("Get", path)}
+// Sprintf is a helper method to define mock.On call
+//   - format
+//   - a
+func (_e *MockRequesterVariadicOneArgumentPartial_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	return &MockRequesterVariadicOneArgumentPartial_Sprintf_Call{Call: _e.mock.On("Sprintf",
+		append([]interface{}{format}, a...)...)}
 }
 
-func (_c *MockRequesterSlice_Get_Call) Run(run func(path string)) *MockRequesterSlice_Get_Call {
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRequesterSlice_Get_Call) Return(strings []string, err error) *MockRequesterSlice_Get_Call {
-	_c.Call.Return(strings, err)
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Return(s string) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockRequesterSlice_Get_Call) RunAndReturn(run func(path string) ([]string, error)) *MockRequesterSlice_Get_Call {
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// newMockrequesterUnexported creates a new instance of mockrequesterUnexported. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func newMockrequesterUnexported(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *mockrequesterUnexported {
-	mock := &mockrequesterUnexported{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// mockrequesterUnexported is an autogenerated mock type for the requesterUnexported type
-type mockrequesterUnexported struct {
-	mock.Mock
-}
-
-type mockrequesterUnexported_Expecter struct {
-	mock *mock.Mock
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Once() *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.Once()
+	return _c
 }
 
-func (_m *mockrequesterUnexported) EXPECT() *mockrequesterUnexported_Expecter {
-	return &mockrequesterUnexported_Expecter{mock: &_m.Mock}
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Twice() *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
 }
 
-// Get provides a mock function for the type mockrequesterUnexported
-func (_mock *mockrequesterUnexported) Get() {
-	_mock.Called()
-	return
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Times(i int) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
 }
 
-// mockrequesterUnexported_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type mockrequesterUnexported_Get_Call struct {
-	*mock.Call
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Maybe() *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
 }
 
-// Get is a helper method to define mock.On call
-func (_e *mockrequesterUnexported_Expecter) Get() *mockrequesterUnexported_Get_Call {
-	return &mockrequesterUnexported_Get_Call{Call: _e.mock.On("Get")}
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
 }
 
-func (_c *mockrequesterUnexported_Get_Call) Run(run func()) *mockrequesterUnexported_Get_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.WaitUntil(w)
 	return _c
 }
 
-func (_c *mockrequesterUnexported_Get_Call) Return() *mockrequesterUnexported_Get_Call {
-	_c.Call.Return()
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
 	return _c
 }
 
-func (_c *mockrequesterUnexported_Get_Call) RunAndReturn(run func()) *mockrequesterUnexported_Get_Call {
-	_c.Run(run)
+func (_c *MockRequesterVariadicOneArgumentPartial_Sprintf_Call) Unset() *MockRequesterVariadicOneArgumentPartial_Sprintf_Call {
+	_c.Call.Unset()
 	return _c
 }
 
-// NewMockRequesterVariadicOneArgument creates a new instance of MockRequesterVariadicOneArgument. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRequesterVariadicPartial creates a new instance of MockRequesterVariadicPartial. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRequesterVariadicOneArgument(t interface {
+func NewMockRequesterVariadicPartial(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRequesterVariadicOneArgument {
-	mock := &MockRequesterVariadicOneArgument{}
+}) *MockRequesterVariadicPartial {
+	mock := &MockRequesterVariadicPartial{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -4169,28 +14943,83 @@ func NewMockRequesterVariadicOneArgument(t interface {
 	return mock
 }
 
-// MockRequesterVariadicOneArgument is an autogenerated mock type for the RequesterVariadic type
-type MockRequesterVariadicOneArgument struct {
+// NewMockRequesterVariadicPartialWithDelegate creates a new instance of MockRequesterVariadicPartial like NewMockRequesterVariadicPartial.
+// Calls that match no expectation are forwarded to delegate, and still recorded.
+func NewMockRequesterVariadicPartialWithDelegate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}, delegate RequesterVariadic) *MockRequesterVariadicPartial {
+	mock := NewMockRequesterVariadicPartial(t)
+	mock.delegate = delegate
+	return mock
+}
+
+// MockRequesterVariadicPartial is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicPartial struct {
 	mock.Mock
+	delegate   RequesterVariadic
+	delegateMu sync.Mutex
+	delegated  map[*mock.Call]bool
 }
 
-type MockRequesterVariadicOneArgument_Expecter struct {
+type MockRequesterVariadicPartial_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRequesterVariadicOneArgument) EXPECT() *MockRequesterVariadicOneArgument_Expecter {
-	return &MockRequesterVariadicOneArgument_Expecter{mock: &_m.Mock}
+func (_m *MockRequesterVariadicPartial) EXPECT() *MockRequesterVariadicPartial_Expecter {
+	return &MockRequesterVariadicPartial_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockRequesterVariadicOneArgument
-func (_mock *MockRequesterVariadicOneArgument) Get(values ...string) bool {
-	var tmpRet mock.Arguments
-	if len(values) > 0 {
-		tmpRet = _mock.Called(values)
-	} else {
-		tmpRet = _mock.Called()
+// _delegateCall reports whether a call should be forwarded to the delegate, which
+// is the case when there is one and no expectation matches the arguments, or all
+// the matching ones are used up. The
+// forwarded call is recorded through a one-off expectation, so that assertions
+// on the calls of the mock still see it.
+func (_mock *MockRequesterVariadicPartial) _delegateCall(method string, args ...interface{}) bool {
+	if _mock.delegate == nil {
+		return false
 	}
-	ret := tmpRet
+	_mock.delegateMu.Lock()
+	for _, call := range _mock.ExpectedCalls {
+		if call.Method != method || _mock.delegated[call] || call.Repeatability == -1 {
+			continue
+		}
+		if _, diffCount := call.Arguments.Diff(args); diffCount == 0 {
+			_mock.delegateMu.Unlock()
+			return false
+		}
+	}
+	anything := make([]interface{}, len(args))
+	for i := range anything {
+		anything[i] = mock.Anything
+	}
+	if _mock.delegated == nil {
+		_mock.delegated = make(map[*mock.Call]bool)
+	}
+	_mock.delegated[_mock.On(method, anything...).Once().Maybe()] = true
+	_mock.delegateMu.Unlock()
+
+	_mock.MethodCalled(method, args...)
+	return true
+}
+
+// Get provides a mock function for the type MockRequesterVariadicPartial
+func (_mock *MockRequesterVariadicPartial) Get(values ...string) bool {
+	var _args []interface{}
+	for _, _a := range values {
+		_args = append(_args, _a)
+	}
+	if _mock._delegateCall("Get", _args...) {
+		return _mock.delegate.Get(values...)
+	}
+	// string
+	_va := make([]interface{}, len(values))
+	for _i := range values {
+		_va[_i] = values[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -4205,19 +15034,19 @@ func (_mock *MockRequesterVariadicOneArgument) Get(values ...string) bool {
 	return r0
 }
 
-// MockRequesterVariadicOneArgument_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterVariadicOneArgument_Get_Call struct {
+// MockRequesterVariadicPartial_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicPartial_Get_Call struct {
 	*mock.Call
 }
 
 // Get is a helper method to define mock.On call
 //   - values
-func (_e *MockRequesterVariadicOneArgument_Expecter) Get(values ...interface{}) *MockRequesterVariadicOneArgument_Get_Call {
-	return &MockRequesterVariadicOneArgument_Get_Call{Call: _e.mock.On("Get",
+func (_e *MockRequesterVariadicPartial_Expecter) Get(values ...interface{}) *MockRequesterVariadicPartial_Get_Call {
+	return &MockRequesterVariadicPartial_Get_Call{Call: _e.mock.On("Get",
 		append([]interface{}{}, values...)...)}
 }
 
-func (_c *MockRequesterVariadicOneArgument_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicOneArgument_Get_Call {
+func (_c *MockRequesterVariadicPartial_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicPartial_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]string, len(args)-0)
 		for i, a := range args[0:] {
@@ -4230,25 +15059,75 @@ func (_c *MockRequesterVariadicOneArgument_Get_Call) Run(run func(values ...stri
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_Get_Call) Return(b bool) *MockRequesterVariadicOneArgument_Get_Call {
+func (_c *MockRequesterVariadicPartial_Get_Call) Return(b bool) *MockRequesterVariadicPartial_Get_Call {
 	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicOneArgument_Get_Call {
+func (_c *MockRequesterVariadicPartial_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicPartial_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MultiWriteToFile provides a mock function for the type MockRequesterVariadicOneArgument
-func (_mock *MockRequesterVariadicOneArgument) MultiWriteToFile(filename string, w ...io.Writer) string {
-	var tmpRet mock.Arguments
-	if len(w) > 0 {
-		tmpRet = _mock.Called(filename, w)
-	} else {
-		tmpRet = _mock.Called(filename)
+func (_c *MockRequesterVariadicPartial_Get_Call) Once() *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) Twice() *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) Times(i int) *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) Maybe() *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) After(d time.Duration) *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Get_Call) Unset() *MockRequesterVariadicPartial_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicPartial
+func (_mock *MockRequesterVariadicPartial) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var _args []interface{}
+	_args = append(_args, filename)
+	for _, _a := range w {
+		_args = append(_args, _a)
 	}
-	ret := tmpRet
+	if _mock._delegateCall("MultiWriteToFile", _args...) {
+		return _mock.delegate.MultiWriteToFile(filename, w...)
+	}
+	// io.Writer
+	_va := make([]interface{}, len(w))
+	for _i := range w {
+		_va[_i] = w[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filename)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MultiWriteToFile")
@@ -4263,20 +15142,20 @@ func (_mock *MockRequesterVariadicOneArgument) MultiWriteToFile(filename string,
 	return r0
 }
 
-// MockRequesterVariadicOneArgument_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
-type MockRequesterVariadicOneArgument_MultiWriteToFile_Call struct {
+// MockRequesterVariadicPartial_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicPartial_MultiWriteToFile_Call struct {
 	*mock.Call
 }
 
 // MultiWriteToFile is a helper method to define mock.On call
 //   - filename
 //   - w
-func (_e *MockRequesterVariadicOneArgument_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
-	return &MockRequesterVariadicOneArgument_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+func (_e *MockRequesterVariadicPartial_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	return &MockRequesterVariadicPartial_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
 		append([]interface{}{filename}, w...)...)}
 }
 
-func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]io.Writer, len(args)-1)
 		for i, a := range args[1:] {
@@ -4289,25 +15168,68 @@ func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Run(run func(f
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicOneArgument_MultiWriteToFile_Call {
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OneInterface provides a mock function for the type MockRequesterVariadicOneArgument
-func (_mock *MockRequesterVariadicOneArgument) OneInterface(a ...interface{}) bool {
-	var tmpRet mock.Arguments
-	if len(a) > 0 {
-		tmpRet = _mock.Called(a)
-	} else {
-		tmpRet = _mock.Called()
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Once() *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Twice() *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_MultiWriteToFile_Call) Unset() *MockRequesterVariadicPartial_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicPartial
+func (_mock *MockRequesterVariadicPartial) OneInterface(a ...interface{}) bool {
+	var _args []interface{}
+	for _, _a := range a {
+		_args = append(_args, _a)
 	}
-	ret := tmpRet
+	if _mock._delegateCall("OneInterface", _args...) {
+		return _mock.delegate.OneInterface(a...)
+	}
+	var _ca []interface{}
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for OneInterface")
@@ -4322,19 +15244,19 @@ func (_mock *MockRequesterVariadicOneArgument) OneInterface(a ...interface{}) bo
 	return r0
 }
 
-// MockRequesterVariadicOneArgument_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
-type MockRequesterVariadicOneArgument_OneInterface_Call struct {
+// MockRequesterVariadicPartial_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicPartial_OneInterface_Call struct {
 	*mock.Call
 }
 
 // OneInterface is a helper method to define mock.On call
 //   - a
-func (_e *MockRequesterVariadicOneArgument_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicOneArgument_OneInterface_Call {
-	return &MockRequesterVariadicOneArgument_OneInterface_Call{Call: _e.mock.On("OneInterface",
+func (_e *MockRequesterVariadicPartial_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicPartial_OneInterface_Call {
+	return &MockRequesterVariadicPartial_OneInterface_Call{Call: _e.mock.On("OneInterface",
 		append([]interface{}{}, a...)...)}
 }
 
-func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicOneArgument_OneInterface_Call {
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicPartial_OneInterface_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]interface{}, len(args)-0)
 		for i, a := range args[0:] {
@@ -4347,25 +15269,70 @@ func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Run(run func(a ...
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) Return(b bool) *MockRequesterVariadicOneArgument_OneInterface_Call {
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Return(b bool) *MockRequesterVariadicPartial_OneInterface_Call {
 	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicOneArgument_OneInterface_Call {
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicPartial_OneInterface_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Sprintf provides a mock function for the type MockRequesterVariadicOneArgument
-func (_mock *MockRequesterVariadicOneArgument) Sprintf(format string, a ...interface{}) string {
-	var tmpRet mock.Arguments
-	if len(a) > 0 {
-		tmpRet = _mock.Called(format, a)
-	} else {
-		tmpRet = _mock.Called(format)
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Once() *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Twice() *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Times(i int) *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Maybe() *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_OneInterface_Call) Unset() *MockRequesterVariadicPartial_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicPartial
+func (_mock *MockRequesterVariadicPartial) Sprintf(format string, a ...interface{}) string {
+	var _args []interface{}
+	_args = append(_args, format)
+	for _, _a := range a {
+		_args = append(_args, _a)
 	}
-	ret := tmpRet
+	if _mock._delegateCall("Sprintf", _args...) {
+		return _mock.delegate.Sprintf(format, a...)
+	}
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, a...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Sprintf")
@@ -4380,20 +15347,20 @@ func (_mock *MockRequesterVariadicOneArgument) Sprintf(format string, a ...inter
 	return r0
 }
 
-// MockRequesterVariadicOneArgument_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
-type MockRequesterVariadicOneArgument_Sprintf_Call struct {
+// MockRequesterVariadicPartial_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicPartial_Sprintf_Call struct {
 	*mock.Call
 }
 
 // Sprintf is a helper method to define mock.On call
 //   - format
 //   - a
-func (_e *MockRequesterVariadicOneArgument_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicOneArgument_Sprintf_Call {
-	return &MockRequesterVariadicOneArgument_Sprintf_Call{Call: _e.mock.On("Sprintf",
+func (_e *MockRequesterVariadicPartial_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicPartial_Sprintf_Call {
+	return &MockRequesterVariadicPartial_Sprintf_Call{Call: _e.mock.On("Sprintf",
 		append([]interface{}{format}, a...)...)}
 }
 
-func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicOneArgument_Sprintf_Call {
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicPartial_Sprintf_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]interface{}, len(args)-1)
 		for i, a := range args[1:] {
@@ -4406,23 +15373,63 @@ func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Run(run func(format str
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) Return(s string) *MockRequesterVariadicOneArgument_Sprintf_Call {
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Return(s string) *MockRequesterVariadicPartial_Sprintf_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockRequesterVariadicOneArgument_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicOneArgument_Sprintf_Call {
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicPartial_Sprintf_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRequesterVariadic creates a new instance of MockRequesterVariadic. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Once() *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Twice() *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Times(i int) *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Maybe() *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicPartial_Sprintf_Call) Unset() *MockRequesterVariadicPartial_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// NewMockRequesterVariadicInOrder creates a new instance of MockRequesterVariadicInOrder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRequesterVariadic(t interface {
+func NewMockRequesterVariadicInOrder(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRequesterVariadic {
-	mock := &MockRequesterVariadic{}
+}) *MockRequesterVariadicInOrder {
+	mock := &MockRequesterVariadicInOrder{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -4430,21 +15437,33 @@ func NewMockRequesterVariadic(t interface {
 	return mock
 }
 
-// MockRequesterVariadic is an autogenerated mock type for the RequesterVariadic type
-type MockRequesterVariadic struct {
+// MockRequesterVariadicInOrder is an autogenerated mock type for the RequesterVariadic type
+type MockRequesterVariadicInOrder struct {
 	mock.Mock
+	seq *sequence.Sequence
 }
 
-type MockRequesterVariadic_Expecter struct {
+type MockRequesterVariadicInOrder_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRequesterVariadic) EXPECT() *MockRequesterVariadic_Expecter {
-	return &MockRequesterVariadic_Expecter{mock: &_m.Mock}
+func (_m *MockRequesterVariadicInOrder) EXPECT() *MockRequesterVariadicInOrder_Expecter {
+	return &MockRequesterVariadicInOrder_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function for the type MockRequesterVariadic
-func (_mock *MockRequesterVariadic) Get(values ...string) bool {
+// InSequence makes the mock record its calls in seq, which may be shared with
+// other mocks. See sequence.Sequence.AssertOrder.
+func (_mock *MockRequesterVariadicInOrder) InSequence(seq *sequence.Sequence) {
+	_mock.seq = seq
+}
+
+// Get provides a mock function for the type MockRequesterVariadicInOrder
+func (_mock *MockRequesterVariadicInOrder) Get(values ...string) bool {
+	var _args []interface{}
+	for _, _a := range values {
+		_args = append(_args, _a)
+	}
+	_mock.seq.Record(&_mock.Mock, "Get", _args...)
 	// string
 	_va := make([]interface{}, len(values))
 	for _i := range values {
@@ -4467,19 +15486,19 @@ func (_mock *MockRequesterVariadic) Get(values ...string) bool {
 	return r0
 }
 
-// MockRequesterVariadic_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockRequesterVariadic_Get_Call struct {
+// MockRequesterVariadicInOrder_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRequesterVariadicInOrder_Get_Call struct {
 	*mock.Call
 }
 
 // Get is a helper method to define mock.On call
 //   - values
-func (_e *MockRequesterVariadic_Expecter) Get(values ...interface{}) *MockRequesterVariadic_Get_Call {
-	return &MockRequesterVariadic_Get_Call{Call: _e.mock.On("Get",
+func (_e *MockRequesterVariadicInOrder_Expecter) Get(values ...interface{}) *MockRequesterVariadicInOrder_Get_Call {
+	return &MockRequesterVariadicInOrder_Get_Call{Call: _e.mock.On("Get",
 		append([]interface{}{}, values...)...)}
 }
 
-func (_c *MockRequesterVariadic_Get_Call) Run(run func(values ...string)) *MockRequesterVariadic_Get_Call {
+func (_c *MockRequesterVariadicInOrder_Get_Call) Run(run func(values ...string)) *MockRequesterVariadicInOrder_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]string, len(args)-0)
 		for i, a := range args[0:] {
@@ -4492,18 +15511,70 @@ func (_c *MockRequesterVariadic_Get_Call) Run(run func(values ...string)) *MockR
 	return _c
 }
 
-func (_c *MockRequesterVariadic_Get_Call) Return(b bool) *MockRequesterVariadic_Get_Call {
+func (_c *MockRequesterVariadicInOrder_Get_Call) Return(b bool) *MockRequesterVariadicInOrder_Get_Call {
 	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockRequesterVariadic_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadic_Get_Call {
+func (_c *MockRequesterVariadicInOrder_Get_Call) RunAndReturn(run func(values ...string) bool) *MockRequesterVariadicInOrder_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MultiWriteToFile provides a mock function for the type MockRequesterVariadic
-func (_mock *MockRequesterVariadic) MultiWriteToFile(filename string, w ...io.Writer) string {
+func (_c *MockRequesterVariadicInOrder_Get_Call) Once() *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) Twice() *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) Times(i int) *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) Maybe() *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) After(d time.Duration) *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Get_Call) Unset() *MockRequesterVariadicInOrder_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockRequesterVariadicInOrder_Get_Call) MockCall() *mock.Call {
+	return _c.Call
+}
+
+// MultiWriteToFile provides a mock function for the type MockRequesterVariadicInOrder
+func (_mock *MockRequesterVariadicInOrder) MultiWriteToFile(filename string, w ...io.Writer) string {
+	var _args []interface{}
+	_args = append(_args, filename)
+	for _, _a := range w {
+		_args = append(_args, _a)
+	}
+	_mock.seq.Record(&_mock.Mock, "MultiWriteToFile", _args...)
 	// io.Writer
 	_va := make([]interface{}, len(w))
 	for _i := range w {
@@ -4527,20 +15598,20 @@ func (_mock *MockRequesterVariadic) MultiWriteToFile(filename string, w ...io.Wr
 	return r0
 }
 
-// MockRequesterVariadic_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
-type MockRequesterVariadic_MultiWriteToFile_Call struct {
+// MockRequesterVariadicInOrder_MultiWriteToFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiWriteToFile'
+type MockRequesterVariadicInOrder_MultiWriteToFile_Call struct {
 	*mock.Call
 }
 
 // MultiWriteToFile is a helper method to define mock.On call
 //   - filename
 //   - w
-func (_e *MockRequesterVariadic_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadic_MultiWriteToFile_Call {
-	return &MockRequesterVariadic_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
+func (_e *MockRequesterVariadicInOrder_Expecter) MultiWriteToFile(filename interface{}, w ...interface{}) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	return &MockRequesterVariadicInOrder_MultiWriteToFile_Call{Call: _e.mock.On("MultiWriteToFile",
 		append([]interface{}{filename}, w...)...)}
 }
 
-func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadic_MultiWriteToFile_Call {
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Run(run func(filename string, w ...io.Writer)) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]io.Writer, len(args)-1)
 		for i, a := range args[1:] {
@@ -4553,18 +15624,69 @@ func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Run(run func(filename str
 	return _c
 }
 
-func (_c *MockRequesterVariadic_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadic_MultiWriteToFile_Call {
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Return(s string) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockRequesterVariadic_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadic_MultiWriteToFile_Call {
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) RunAndReturn(run func(filename string, w ...io.Writer) string) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OneInterface provides a mock function for the type MockRequesterVariadic
-func (_mock *MockRequesterVariadic) OneInterface(a ...interface{}) bool {
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Once() *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Twice() *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Times(i int) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Maybe() *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) After(d time.Duration) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) Unset() *MockRequesterVariadicInOrder_MultiWriteToFile_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockRequesterVariadicInOrder_MultiWriteToFile_Call) MockCall() *mock.Call {
+	return _c.Call
+}
+
+// OneInterface provides a mock function for the type MockRequesterVariadicInOrder
+func (_mock *MockRequesterVariadicInOrder) OneInterface(a ...interface{}) bool {
+	var _args []interface{}
+	for _, _a := range a {
+		_args = append(_args, _a)
+	}
+	_mock.seq.Record(&_mock.Mock, "OneInterface", _args...)
 	var _ca []interface{}
 	_ca = append(_ca, a...)
 	ret := _mock.Called(_ca...)
@@ -4582,19 +15704,19 @@ func (_mock *MockRequesterVariadic) OneInterface(a ...interface{}) bool {
 	return r0
 }
 
-// MockRequesterVariadic_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
-type MockRequesterVariadic_OneInterface_Call struct {
+// MockRequesterVariadicInOrder_OneInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OneInterface'
+type MockRequesterVariadicInOrder_OneInterface_Call struct {
 	*mock.Call
 }
 
 // OneInterface is a helper method to define mock.On call
 //   - a
-func (_e *MockRequesterVariadic_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadic_OneInterface_Call {
-	return &MockRequesterVariadic_OneInterface_Call{Call: _e.mock.On("OneInterface",
+func (_e *MockRequesterVariadicInOrder_Expecter) OneInterface(a ...interface{}) *MockRequesterVariadicInOrder_OneInterface_Call {
+	return &MockRequesterVariadicInOrder_OneInterface_Call{Call: _e.mock.On("OneInterface",
 		append([]interface{}{}, a...)...)}
 }
 
-func (_c *MockRequesterVariadic_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadic_OneInterface_Call {
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Run(run func(a ...interface{})) *MockRequesterVariadicInOrder_OneInterface_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]interface{}, len(args)-0)
 		for i, a := range args[0:] {
@@ -4607,18 +15729,70 @@ func (_c *MockRequesterVariadic_OneInterface_Call) Run(run func(a ...interface{}
 	return _c
 }
 
-func (_c *MockRequesterVariadic_OneInterface_Call) Return(b bool) *MockRequesterVariadic_OneInterface_Call {
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Return(b bool) *MockRequesterVariadicInOrder_OneInterface_Call {
 	_c.Call.Return(b)
 	return _c
 }
 
-func (_c *MockRequesterVariadic_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadic_OneInterface_Call {
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) RunAndReturn(run func(a ...interface{}) bool) *MockRequesterVariadicInOrder_OneInterface_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Sprintf provides a mock function for the type MockRequesterVariadic
-func (_mock *MockRequesterVariadic) Sprintf(format string, a ...interface{}) string {
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Once() *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Twice() *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Times(i int) *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Maybe() *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) After(d time.Duration) *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) Unset() *MockRequesterVariadicInOrder_OneInterface_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockRequesterVariadicInOrder_OneInterface_Call) MockCall() *mock.Call {
+	return _c.Call
+}
+
+// Sprintf provides a mock function for the type MockRequesterVariadicInOrder
+func (_mock *MockRequesterVariadicInOrder) Sprintf(format string, a ...interface{}) string {
+	var _args []interface{}
+	_args = append(_args, format)
+	for _, _a := range a {
+		_args = append(_args, _a)
+	}
+	_mock.seq.Record(&_mock.Mock, "Sprintf", _args...)
 	var _ca []interface{}
 	_ca = append(_ca, format)
 	_ca = append(_ca, a...)
@@ -4637,20 +15811,20 @@ func (_mock *MockRequesterVariadic) Sprintf(format string, a ...interface{}) str
 	return r0
 }
 
-// MockRequesterVariadic_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
-type MockRequesterVariadic_Sprintf_Call struct {
+// MockRequesterVariadicInOrder_Sprintf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sprintf'
+type MockRequesterVariadicInOrder_Sprintf_Call struct {
 	*mock.Call
 }
 
 // Sprintf is a helper method to define mock.On call
 //   - format
 //   - a
-func (_e *MockRequesterVariadic_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadic_Sprintf_Call {
-	return &MockRequesterVariadic_Sprintf_Call{Call: _e.mock.On("Sprintf",
+func (_e *MockRequesterVariadicInOrder_Expecter) Sprintf(format interface{}, a ...interface{}) *MockRequesterVariadicInOrder_Sprintf_Call {
+	return &MockRequesterVariadicInOrder_Sprintf_Call{Call: _e.mock.On("Sprintf",
 		append([]interface{}{format}, a...)...)}
 }
 
-func (_c *MockRequesterVariadic_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadic_Sprintf_Call {
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Run(run func(format string, a ...interface{})) *MockRequesterVariadicInOrder_Sprintf_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]interface{}, len(args)-1)
 		for i, a := range args[1:] {
@@ -4663,16 +15837,62 @@ func (_c *MockRequesterVariadic_Sprintf_Call) Run(run func(format string, a ...i
 	return _c
 }
 
-func (_c *MockRequesterVariadic_Sprintf_Call) Return(s string) *MockRequesterVariadic_Sprintf_Call {
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Return(s string) *MockRequesterVariadicInOrder_Sprintf_Call {
 	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockRequesterVariadic_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadic_Sprintf_Call {
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) RunAndReturn(run func(format string, a ...interface{}) string) *MockRequesterVariadicInOrder_Sprintf_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Once() *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Twice() *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Times(i int) *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Maybe() *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) After(d time.Duration) *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) WaitUntil(w <-chan time.Time) *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) NotBefore(calls ...*mock.Call) *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) Unset() *MockRequesterVariadicInOrder_Sprintf_Call {
+	_c.Call.Unset()
+	return _c
+}
+
+// MockCall returns the underlying *mock.Call, so that the call can be passed to
+// sequence.InOrder and sequence.Sequence.AssertOrder.
+func (_c *MockRequesterVariadicInOrder_Sprintf_Call) MockCall() *mock.Call {
+	return _c.Call
+}
+
 // NewMockExample creates a new instance of MockExample. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockExample(t interface {
@@ -4746,6 +15966,46 @@ func (_c *MockExample_A_Call) RunAndReturn(run func() http.Flusher) *MockExample
 	return _c
 }
 
+func (_c *MockExample_A_Call) Once() *MockExample_A_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockExample_A_Call) Twice() *MockExample_A_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockExample_A_Call) Times(i int) *MockExample_A_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockExample_A_Call) Maybe() *MockExample_A_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockExample_A_Call) After(d time.Duration) *MockExample_A_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockExample_A_Call) WaitUntil(w <-chan time.Time) *MockExample_A_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockExample_A_Call) NotBefore(calls ...*mock.Call) *MockExample_A_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExample_A_Call) Unset() *MockExample_A_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // B provides a mock function for the type MockExample
 func (_mock *MockExample) B(fixtureshttp string) http0.MyStruct {
 	ret := _mock.Called(fixtureshttp)
@@ -4791,6 +16051,46 @@ func (_c *MockExample_B_Call) RunAndReturn(run func(fixtureshttp string) http0.M
 	return _c
 }
 
+func (_c *MockExample_B_Call) Once() *MockExample_B_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockExample_B_Call) Twice() *MockExample_B_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockExample_B_Call) Times(i int) *MockExample_B_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockExample_B_Call) Maybe() *MockExample_B_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockExample_B_Call) After(d time.Duration) *MockExample_B_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockExample_B_Call) WaitUntil(w <-chan time.Time) *MockExample_B_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockExample_B_Call) NotBefore(calls ...*mock.Call) *MockExample_B_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExample_B_Call) Unset() *MockExample_B_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // C provides a mock function for the type MockExample
 func (_mock *MockExample) C(fixtureshttp string) http1.MyStruct {
 	ret := _mock.Called(fixtureshttp)
@@ -4836,6 +16136,46 @@ func (_c *MockExample_C_Call) RunAndReturn(run func(fixtureshttp string) http1.M
 	return _c
 }
 
+func (_c *MockExample_C_Call) Once() *MockExample_C_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockExample_C_Call) Twice() *MockExample_C_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockExample_C_Call) Times(i int) *MockExample_C_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockExample_C_Call) Maybe() *MockExample_C_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockExample_C_Call) After(d time.Duration) *MockExample_C_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockExample_C_Call) WaitUntil(w <-chan time.Time) *MockExample_C_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockExample_C_Call) NotBefore(calls ...*mock.Call) *MockExample_C_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockExample_C_Call) Unset() *MockExample_C_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockA creates a new instance of MockA. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockA(t interface {
@@ -4916,6 +16256,46 @@ func (_c *MockA_Call_Call) RunAndReturn(run func() (B, error)) *MockA_Call_Call
 	return _c
 }
 
+func (_c *MockA_Call_Call) Once() *MockA_Call_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockA_Call_Call) Twice() *MockA_Call_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockA_Call_Call) Times(i int) *MockA_Call_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockA_Call_Call) Maybe() *MockA_Call_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockA_Call_Call) After(d time.Duration) *MockA_Call_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockA_Call_Call) WaitUntil(w <-chan time.Time) *MockA_Call_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockA_Call_Call) NotBefore(calls ...*mock.Call) *MockA_Call_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockA_Call_Call) Unset() *MockA_Call_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockStructWithTag creates a new instance of MockStructWithTag. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStructWithTag(t interface {
@@ -5023,6 +16403,46 @@ func (_c *MockStructWithTag_MethodA_Call) RunAndReturn(run func(v *struct {
 	return _c
 }
 
+func (_c *MockStructWithTag_MethodA_Call) Once() *MockStructWithTag_MethodA_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) Twice() *MockStructWithTag_MethodA_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) Times(i int) *MockStructWithTag_MethodA_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) Maybe() *MockStructWithTag_MethodA_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) After(d time.Duration) *MockStructWithTag_MethodA_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) WaitUntil(w <-chan time.Time) *MockStructWithTag_MethodA_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) NotBefore(calls ...*mock.Call) *MockStructWithTag_MethodA_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockStructWithTag_MethodA_Call) Unset() *MockStructWithTag_MethodA_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockUnsafeInterface creates a new instance of MockUnsafeInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUnsafeInterface(t interface {
@@ -5084,6 +16504,46 @@ func (_c *MockUnsafeInterface_Do_Call) RunAndReturn(run func(ptr *unsafe.Pointer
 	return _c
 }
 
+func (_c *MockUnsafeInterface_Do_Call) Once() *MockUnsafeInterface_Do_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) Twice() *MockUnsafeInterface_Do_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) Times(i int) *MockUnsafeInterface_Do_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) Maybe() *MockUnsafeInterface_Do_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) After(d time.Duration) *MockUnsafeInterface_Do_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) WaitUntil(w <-chan time.Time) *MockUnsafeInterface_Do_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) NotBefore(calls ...*mock.Call) *MockUnsafeInterface_Do_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockUnsafeInterface_Do_Call) Unset() *MockUnsafeInterface_Do_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockVariadic creates a new instance of MockVariadic. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockVariadic(t interface {
@@ -5157,6 +16617,46 @@ func (_c *MockVariadic_VariadicFunction_Call) RunAndReturn(run func(str string,
 	return _c
 }
 
+func (_c *MockVariadic_VariadicFunction_Call) Once() *MockVariadic_VariadicFunction_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) Twice() *MockVariadic_VariadicFunction_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) Times(i int) *MockVariadic_VariadicFunction_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) Maybe() *MockVariadic_VariadicFunction_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) After(d time.Duration) *MockVariadic_VariadicFunction_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) WaitUntil(w <-chan time.Time) *MockVariadic_VariadicFunction_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) NotBefore(calls ...*mock.Call) *MockVariadic_VariadicFunction_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockVariadic_VariadicFunction_Call) Unset() *MockVariadic_VariadicFunction_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockVariadicReturnFunc creates a new instance of MockVariadicReturnFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockVariadicReturnFunc(t interface {
@@ -5230,3 +16730,43 @@ func (_c *MockVariadicReturnFunc_SampleMethod_Call) RunAndReturn(run func(str st
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) Once() *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) Twice() *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) Times(i int) *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) Maybe() *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) After(d time.Duration) *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) WaitUntil(w <-chan time.Time) *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) NotBefore(calls ...*mock.Call) *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockVariadicReturnFunc_SampleMethod_Call) Unset() *MockVariadicReturnFunc_SampleMethod_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestPartialMock(t *testing.T) {
+	errLocked := errors.New("locked")
+	ctx := context.Background()
+
+	m := NewMockAuthenticatorPartialWithDelegate(t, fakeAuthenticator{})
+	m.EXPECT().Login(mock.Anything, "mallory", mock.Anything).Return("", errLocked).Once()
+
+	token, err := m.Login(ctx, "alice", "hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, "token-alice", token)
+	_, err = m.Login(ctx, "mallory", "hunter2")
+	assert.ErrorIs(t, err, errLocked)
+	assert.NoError(t, m.Logout(ctx, token))
+	m.Ping(1)
+
+	m.AssertCalled(t, "Login", ctx, "alice", "hunter2")
+	m.AssertNumberOfCalls(t, "Login", 2)
+	m.AssertCalled(t, "Logout", ctx, "token-alice")
+	m.AssertCalled(t, "Ping", 1)
+}
+
+func TestPartialMockUsedUpExpectation(t *testing.T) {
+	errLocked := errors.New("locked")
+	ctx := context.Background()
+
+	m := NewMockAuthenticatorPartialWithDelegate(t, fakeAuthenticator{})
+	m.EXPECT().Login(ctx, "alice", "hunter2").Return("", errLocked).Once()
+
+	_, err := m.Login(ctx, "alice", "hunter2")
+	assert.ErrorIs(t, err, errLocked)
+	token, err := m.Login(ctx, "alice", "hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, "token-alice", token)
+	m.AssertNumberOfCalls(t, "Login", 2)
+}
+
+func TestPartialMockConcurrent(t *testing.T) {
+	m := NewMockAuthenticatorPartialWithDelegate(t, fakeAuthenticator{})
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Ping(i % 2)
+		}()
+	}
+	wg.Wait()
+	m.AssertNumberOfCalls(t, "Ping", 10)
+}
+
+func TestPartialMockWithoutDelegate(t *testing.T) {
+	m := NewMockAuthenticatorPartial(t)
+	m.EXPECT().Ping(1).Return().Once()
+	m.Ping(1)
+}
+
+type variadicRequester struct{}
+
+func (variadicRequester) Get(values ...string) bool { return len(values) > 0 }
+
+func (variadicRequester) OneInterface(a ...interface{}) bool { return len(a) > 0 }
+
+func (variadicRequester) Sprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(format, a...)
+}
+
+func (variadicRequester) MultiWriteToFile(filename string, w ...io.Writer) string {
+	return filename
+}
+
+func TestPartialMockVariadic(t *testing.T) {
+	unrolled := NewMockRequesterVariadicPartialWithDelegate(t, variadicRequester{})
+	unrolled.EXPECT().Get("a", "b").Return(false).Once()
+	assert.False(t, unrolled.Get("a", "b"))
+	assert.True(t, unrolled.Get("c"))
+	assert.Equal(t, "a 1", unrolled.Sprintf("%s %d", "a", 1))
+	unrolled.AssertCalled(t, "Sprintf", "%s %d", "a", 1)
+
+	rolled := NewMockRequesterVariadicOneArgumentPartialWithDelegate(t, variadicRequester{})
+	assert.Equal(t, "a", rolled.Sprintf("%s", "a"))
+	assert.False(t, rolled.Get())
+	rolled.AssertCalled(t, "Sprintf", "%s", []interface{}{"a"})
+	rolled.AssertCalled(t, "Get")
+}
@@ -0,0 +1,51 @@
+package test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektra/mockery/v3/recording"
+)
+
+func TestRecordAndReplayRequester(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requester.golden.json")
+
+	recorder := recording.NewRecorder(path)
+	wrapped := NewRecordingRequester(&StubRequester{
+		GetFunc: func(path string) (string, error) {
+			if path == "missing" {
+				return "", errors.New("not found")
+			}
+			return path + " world", nil
+		},
+	}, recorder)
+	_, _ = wrapped.Get("hello")
+	_, _ = wrapped.Get("missing")
+	require.NoError(t, recorder.Save())
+
+	replayed := NewReplayingRequester(t, path)
+	retString, err := replayed.Get("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", retString)
+	_, err = replayed.Get("missing")
+	assert.EqualError(t, err, "not found")
+}
+
+func TestRecordAndReplayRequesterVariadic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requester_variadic.golden.json")
+
+	recorder := recording.NewRecorder(path)
+	wrapped := NewRecordingRequesterVariadic(&StubRequesterVariadic{
+		GetFunc: func(values ...string) bool {
+			return len(values) == 2
+		},
+	}, recorder)
+	wrapped.Get("a", "b")
+	require.NoError(t, recorder.Save())
+
+	replayed := NewReplayingRequesterVariadic(t, path)
+	assert.True(t, replayed.Get("a", "b"))
+}
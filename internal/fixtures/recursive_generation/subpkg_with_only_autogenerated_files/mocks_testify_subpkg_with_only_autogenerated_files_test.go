@@ -6,6 +6,8 @@
 package subpkg_with_only_autogenerated_files
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -79,3 +81,43 @@ func (_c *MockFoo_Get_Call) RunAndReturn(run func() string) *MockFoo_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *MockFoo_Get_Call) Once() *MockFoo_Get_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) Twice() *MockFoo_Get_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) Times(i int) *MockFoo_Get_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) Maybe() *MockFoo_Get_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) After(d time.Duration) *MockFoo_Get_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) WaitUntil(w <-chan time.Time) *MockFoo_Get_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) NotBefore(calls ...*mock.Call) *MockFoo_Get_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockFoo_Get_Call) Unset() *MockFoo_Get_Call {
+	_c.Call.Unset()
+	return _c
+}
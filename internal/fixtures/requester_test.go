@@ -46,6 +46,33 @@ func TestRequesterMockTestifyEmbed(t *testing.T) {
 	assert.Len(t, m.Mock.Calls, 2)
 }
 
+func TestRequesterMockTypedChain(t *testing.T) {
+	m := NewMockRequester(t)
+	first := m.EXPECT().Get("first").Once().Return("1", nil)
+	m.EXPECT().Get("second").Times(2).NotBefore(first.Call).RunAndReturn(func(path string) (string, error) {
+		return path + "!", nil
+	})
+	m.EXPECT().Get("unused").Maybe().Return("", nil)
+
+	retString, err := m.Get("first")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", retString)
+	for range 2 {
+		retString, err = m.Get("second")
+		assert.NoError(t, err)
+		assert.Equal(t, "second!", retString)
+	}
+}
+
+func TestRequesterMockTypedUnset(t *testing.T) {
+	m := NewMockRequester(t)
+	m.EXPECT().Get("foo").Return("bar", nil).Unset()
+	m.EXPECT().Get("foo").Return("baz", nil).Once()
+	retString, err := m.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "baz", retString)
+}
+
 func TestRequesterMoq(t *testing.T) {
 	m := &MoqRequester{
 		GetFunc: func(path string) (string, error) {
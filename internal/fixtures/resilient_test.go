@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektra/mockery/v3/resilience"
+)
+
+func TestResilientRetries(t *testing.T) {
+	calls := 0
+	auth := NewResilientAuthenticator(&StubAuthenticator{
+		LoginFunc: func(ctx context.Context, user string, secret string) (string, error) {
+			calls++
+			if calls < 3 {
+				return "", errors.New("unavailable")
+			}
+			return "token-" + user, nil
+		},
+	})
+
+	token, err := auth.Login(context.Background(), "alice", "hunter2")
+	assert.NoError(t, err)
+	assert.Equal(t, "token-alice", token)
+	assert.Equal(t, 3, calls)
+}
+
+func TestResilientDurations(t *testing.T) {
+	auth := NewResilientAuthenticator(&StubAuthenticator{})
+	assert.Equal(t, 500*time.Microsecond, auth.loginPolicy.InitialBackoff)
+	assert.Equal(t, 10*time.Millisecond, auth.loginPolicy.MaxBackoff)
+	assert.Equal(t, 1500*time.Millisecond, auth.logoutPolicy.Timeout)
+}
+
+func TestResilientPerMethodPolicy(t *testing.T) {
+	calls := 0
+	auth := NewResilientAuthenticator(&StubAuthenticator{
+		LogoutFunc: func(ctx context.Context, token string) error {
+			calls++
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return errors.New("unavailable")
+		},
+	})
+
+	assert.EqualError(t, auth.Logout(context.Background(), "token"), "unavailable")
+	assert.Equal(t, 1, calls)
+}
+
+func TestResilientCircuitBreaker(t *testing.T) {
+	calls := 0
+	auth := NewResilientAuthenticator(&StubAuthenticator{
+		LogoutFunc: func(ctx context.Context, token string) error {
+			calls++
+			return errors.New("unavailable")
+		},
+	})
+
+	for range 5 {
+		assert.EqualError(t, auth.Logout(context.Background(), "token"), "unavailable")
+	}
+	assert.ErrorIs(t, auth.Logout(context.Background(), "token"), resilience.ErrOpen)
+	assert.Equal(t, 5, calls)
+}
+
+func TestResilientPassThrough(t *testing.T) {
+	var got int
+	auth := NewResilientAuthenticator(&StubAuthenticator{
+		PingFunc: func(n int) { got = n },
+	})
+	auth.Ping(3)
+	assert.Equal(t, 3, got)
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptedMatryer(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	m := &MoqRequesterScripted{
+		GetFuncs: []func(path string) (string, error){
+			func(path string) (string, error) { return "", errUnavailable },
+			nil,
+			func(path string) (string, error) { return "third " + path, nil },
+		},
+		GetFunc: func(path string) (string, error) { return "fallback " + path, nil },
+	}
+
+	_, err := m.Get("/a")
+	assert.ErrorIs(t, err, errUnavailable)
+	got, _ := m.Get("/b")
+	assert.Equal(t, "fallback /b", got)
+	got, _ = m.Get("/c")
+	assert.Equal(t, "third /c", got)
+	got, _ = m.Get("/d")
+	assert.Equal(t, "fallback /d", got)
+	assert.Len(t, m.GetCalls(), 4)
+
+	m.ResetGetCalls()
+	_, err = m.Get("/a")
+	assert.ErrorIs(t, err, errUnavailable)
+}
+
+func TestScriptedMatryerExhausted(t *testing.T) {
+	m := &MoqRequesterScripted{
+		GetFuncs: []func(path string) (string, error){
+			func(path string) (string, error) { return "first", nil },
+		},
+	}
+
+	got, _ := m.Get("/a")
+	assert.Equal(t, "first", got)
+	assert.PanicsWithValue(t, "MoqRequesterScripted.GetFunc: method is nil and GetFuncs has 1 entries but Requester.Get was just called 2 times", func() {
+		_, _ = m.Get("/b")
+	})
+}
+
+func TestScriptedMatryerStub(t *testing.T) {
+	var pinged []int
+	m := &MoqAuthenticatorScripted{
+		PingFuncs: []func(n int){
+			func(n int) { pinged = append(pinged, n) },
+		},
+	}
+
+	m.Ping(1)
+	m.Ping(2)
+	assert.Equal(t, []int{1}, pinged)
+	token, err := m.Login(context.Background(), "alice", "hunter2")
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+}
@@ -0,0 +1,34 @@
+package test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStubRequesterZeroValues(t *testing.T) {
+	stub := &StubRequester{}
+	retString, err := stub.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "", retString)
+}
+
+func TestStubRequesterFunc(t *testing.T) {
+	stub := &StubRequester{
+		GetFunc: func(path string) (string, error) {
+			return path + " world", nil
+		},
+	}
+	retString, err := stub.Get("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", retString)
+}
+
+func TestStubGeneric(t *testing.T) {
+	stub := &StubRequesterGenerics[int, string, int, GetInt, io.Writer, GetGeneric[int], uint, int]{}
+	signed, intf := stub.GenericArguments(1, "a")
+	assert.Zero(t, signed)
+	assert.Nil(t, intf)
+	assert.Equal(t, GenericType[int, GetInt]{}, stub.GenericStructs(GenericType[int, GetInt]{}))
+}
@@ -0,0 +1,4 @@
+// Package thirdparty contains the mocks of the fixtures generated by templates
+// whose mocks depend on third-party modules, such as gomock and otel. It is a
+// separate module so that the root module doesn't require these dependencies.
+package thirdparty
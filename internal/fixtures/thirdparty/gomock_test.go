@@ -0,0 +1,66 @@
+package thirdparty
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGomockRequester(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewGomockRequester(ctrl)
+	m.EXPECT().Get("foo").Return("bar", nil)
+	retString, err := m.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", retString)
+}
+
+func TestGomockRequesterDoAndReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewGomockRequester(ctrl)
+	m.EXPECT().Get(gomock.Any()).DoAndReturn(func(path string) (string, error) {
+		return path + " world", nil
+	}).Times(2)
+	for range 2 {
+		retString, err := m.Get("hello")
+		assert.NoError(t, err)
+		assert.Equal(t, "hello world", retString)
+	}
+}
+
+func TestGomockRequesterVariadic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewGomockRequesterVariadic(ctrl)
+	m.EXPECT().Sprintf("%s %d", "foo", 1).Return("foo 1")
+	m.EXPECT().Get().Return(true)
+	var got []string
+	m.EXPECT().Get("a", gomock.Any()).Do(func(values ...string) bool {
+		got = values
+		return false
+	})
+
+	assert.Equal(t, "foo 1", m.Sprintf("%s %d", "foo", 1))
+	assert.True(t, m.Get())
+	assert.False(t, m.Get("a", "b"))
+	assert.Equal(t, []string{"a", "b"}, got)
+}
+
+func TestGomockGeneric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewGomockGenericInterface[string](ctrl)
+	m.EXPECT().Func(gomock.Any()).DoAndReturn(func(arg *string) int {
+		return len(*arg)
+	})
+	arg := "hello"
+	assert.Equal(t, 5, m.Func(&arg))
+}
+
+func TestGomockRetNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := NewGomockRetNames(ctrl)
+	m.EXPECT().Get("a", 1).Return("b", nil)
+	got, err := m.Get("a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got)
+}
@@ -0,0 +1,59 @@
+package thirdparty
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) Login(ctx context.Context, user string, secret string) (string, error) {
+	if secret != "hunter2" {
+		return "", errors.New("bad credentials")
+	}
+	return "token-" + user, nil
+}
+
+func (fakeAuthenticator) Logout(ctx context.Context, token string) error {
+	return nil
+}
+
+func (fakeAuthenticator) Ping(n int) {}
+
+func TestOtelDecorator(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	auth := NewOtelAuthenticator(fakeAuthenticator{}, provider.Tracer("test"))
+
+	_, err := auth.Login(context.Background(), "alice", "hunter2")
+	require.NoError(t, err)
+	_, err = auth.Login(context.Background(), "bob", "wrong")
+	assert.Error(t, err)
+	auth.Ping(3)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	assert.Equal(t, "Authenticator.Login", spans[0].Name())
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("user", "alice"),
+		attribute.String("secret", "[REDACTED]"),
+	}, spans[0].Attributes())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "bad credentials", spans[1].Status().Description)
+	require.Len(t, spans[1].Events(), 1)
+	assert.Equal(t, "exception", spans[1].Events()[0].Name)
+
+	assert.Equal(t, "Authenticator.Ping", spans[2].Name())
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("n", 3)}, spans[2].Attributes())
+}
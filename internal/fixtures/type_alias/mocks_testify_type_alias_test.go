@@ -6,6 +6,8 @@
 package type_alias
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 	"github.com/vektra/mockery/v3/internal/fixtures/type_alias/subpkg"
 )
@@ -81,6 +83,46 @@ func (_c *MockInterface1_Foo_Call) RunAndReturn(run func() Type) *MockInterface1
 	return _c
 }
 
+func (_c *MockInterface1_Foo_Call) Once() *MockInterface1_Foo_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) Twice() *MockInterface1_Foo_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) Times(i int) *MockInterface1_Foo_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) Maybe() *MockInterface1_Foo_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) After(d time.Duration) *MockInterface1_Foo_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) WaitUntil(w <-chan time.Time) *MockInterface1_Foo_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) NotBefore(calls ...*mock.Call) *MockInterface1_Foo_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockInterface1_Foo_Call) Unset() *MockInterface1_Foo_Call {
+	_c.Call.Unset()
+	return _c
+}
+
 // NewMockInterface2 creates a new instance of MockInterface2. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockInterface2(t interface {
@@ -143,3 +185,43 @@ func (_c *MockInterface2_F_Call) RunAndReturn(run func(v Type, v1 S, s subpkg.S)
 	_c.Run(run)
 	return _c
 }
+
+func (_c *MockInterface2_F_Call) Once() *MockInterface2_F_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) Twice() *MockInterface2_F_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) Times(i int) *MockInterface2_F_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) Maybe() *MockInterface2_F_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) After(d time.Duration) *MockInterface2_F_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) WaitUntil(w <-chan time.Time) *MockInterface2_F_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) NotBefore(calls ...*mock.Call) *MockInterface2_F_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *MockInterface2_F_Call) Unset() *MockInterface2_F_Call {
+	_c.Call.Unset()
+	return _c
+}
@@ -0,0 +1,47 @@
+package test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektra/mockery/v3/arg"
+)
+
+func TestTypedArgs(t *testing.T) {
+	m := NewMockRequesterTypedArgs(t)
+	m.EXPECT().Get(arg.Eq("/exact")).Return("exact", nil).Once()
+	m.EXPECT().Get(arg.Match(func(path string) bool {
+		return strings.HasPrefix(path, "/api/")
+	})).Return("matched", nil).Once()
+	m.EXPECT().Get(arg.Any[string]()).Return("any", nil).Once()
+
+	got, err := m.Get("/exact")
+	assert.NoError(t, err)
+	assert.Equal(t, "exact", got)
+	got, _ = m.Get("/api/users")
+	assert.Equal(t, "matched", got)
+	got, _ = m.Get("/other")
+	assert.Equal(t, "any", got)
+}
+
+func TestTypedArgsVariadic(t *testing.T) {
+	m := NewMockRequesterVariadicTypedArgs(t)
+	m.EXPECT().Sprintf(arg.Eq("%s %d"), arg.Eq[interface{}]("a"), arg.Any[interface{}]()).Return("a 1").Once()
+	m.EXPECT().Get().Return(true).Once()
+	assert.Equal(t, "a 1", m.Sprintf("%s %d", "a", 1))
+	assert.True(t, m.Get())
+
+	rolled := NewMockRequesterVariadicOneArgumentTypedArgs(t)
+	rolled.EXPECT().Sprintf(arg.Eq("%s"), arg.Eq([]interface{}{"a"})).Return("a").Once()
+	assert.Equal(t, "a", rolled.Sprintf("%s", "a"))
+}
+
+func TestTypedArgsGenerics(t *testing.T) {
+	m := NewMockRequesterGenericsTypedArgs[int, string, int, GetInt, io.Writer, GetGeneric[int], int, int](t)
+	m.EXPECT().GenericArguments(arg.Eq(1), arg.Match(func(s string) bool { return s != "" })).Return(3, nil).Once()
+	a, b := m.GenericArguments(1, "x")
+	assert.Equal(t, 3, a)
+	assert.Equal(t, GetInt(nil), b)
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestTypedCalls(t *testing.T) {
+	m := NewMockRequesterTypedCalls(t)
+	m.EXPECT().Get(mock.Anything).Return("", nil)
+
+	_, _ = m.Get("/first")
+	_, _ = m.Get("/second")
+
+	assert.Equal(t, []MockRequesterTypedCalls_Get_CallArgs{
+		{Path: "/first"},
+		{Path: "/second"},
+	}, m.GetCalls())
+	assert.True(t, m.AssertGetCalled(t, "/first"))
+	assert.True(t, m.AssertGetNotCalled(t, "/third"))
+}
+
+func TestTypedCallsMatchMockCalls(t *testing.T) {
+	m := &MockRequesterTypedCalls{}
+	m.On("Get", "/no-returns")
+
+	assert.Panics(t, func() { _, _ = m.Get("/unexpected") })
+	assert.Empty(t, m.GetCalls())
+	assert.Empty(t, m.Calls)
+
+	assert.PanicsWithValue(t, "no return value specified for Get", func() { _, _ = m.Get("/no-returns") })
+	assert.Equal(t, []MockRequesterTypedCalls_Get_CallArgs{{Path: "/no-returns"}}, m.GetCalls())
+	assert.Len(t, m.Calls, 1)
+}
+
+func TestTypedCallsConcurrent(t *testing.T) {
+	m := NewMockRequesterTypedCalls(t)
+	m.EXPECT().Get(mock.Anything).Return("", nil)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _ = m.Get(fmt.Sprintf("/path/%d", i))
+		}()
+		go func() {
+			defer wg.Done()
+			_ = m.GetCalls()
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, m.GetCalls(), 10)
+}
+
+func TestTypedCallsVariadic(t *testing.T) {
+	var buf bytes.Buffer
+
+	m := NewMockRequesterVariadicTypedCalls(t)
+	m.EXPECT().Get(mock.Anything, mock.Anything).Return(true)
+	m.EXPECT().MultiWriteToFile("out", &buf).Return("")
+	m.Get("a", "b")
+	m.MultiWriteToFile("out", &buf)
+
+	assert.Equal(t, []MockRequesterVariadicTypedCalls_Get_CallArgs{
+		{Values: []string{"a", "b"}},
+	}, m.GetCalls())
+	assert.Equal(t, []MockRequesterVariadicTypedCalls_MultiWriteToFile_CallArgs{
+		{Filename: "out", W: []io.Writer{&buf}},
+	}, m.MultiWriteToFileCalls())
+	assert.True(t, m.AssertGetCalled(t, "a", "b"))
+	assert.True(t, m.AssertMultiWriteToFileCalled(t, "out", &buf))
+
+	rolled := NewMockRequesterVariadicOneArgumentTypedCalls(t)
+	rolled.EXPECT().Sprintf("%s", []interface{}{"a"}).Return("a")
+	rolled.EXPECT().Get().Return(true)
+	rolled.Sprintf("%s", "a")
+	rolled.Get()
+
+	assert.Equal(t, []MockRequesterVariadicOneArgumentTypedCalls_Sprintf_CallArgs{
+		{Format: "%s", A: []interface{}{"a"}},
+	}, rolled.SprintfCalls())
+	assert.Equal(t, []MockRequesterVariadicOneArgumentTypedCalls_Get_CallArgs{
+		{},
+	}, rolled.GetCalls())
+	assert.True(t, rolled.AssertSprintfCalled(t, "%s", "a"))
+	assert.True(t, rolled.AssertGetCalled(t))
+}
+
+func TestTypedCallsGenerics(t *testing.T) {
+	m := NewMockRequesterGenericsTypedCalls[int, string, int, GetInt, io.Writer, GetGeneric[int], int, int](t)
+	m.EXPECT().GenericArguments(1, "a").Return(0, nil)
+	m.GenericArguments(1, "a")
+
+	calls := m.GenericArgumentsCalls()
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, 1, calls[0].V)
+		assert.Equal(t, "a", calls[0].V1)
+	}
+	assert.True(t, m.AssertGenericArgumentsCalled(t, 1, "a"))
+}
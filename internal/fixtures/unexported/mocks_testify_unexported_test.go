@@ -6,6 +6,8 @@
 package unexported
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -79,3 +81,43 @@ func (_c *mockfoo_Bar_Call) RunAndReturn(run func() string) *mockfoo_Bar_Call {
 	_c.Call.Return(run)
 	return _c
 }
+
+func (_c *mockfoo_Bar_Call) Once() *mockfoo_Bar_Call {
+	_c.Call.Once()
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) Twice() *mockfoo_Bar_Call {
+	_c.Call.Twice()
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) Times(i int) *mockfoo_Bar_Call {
+	_c.Call.Times(i)
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) Maybe() *mockfoo_Bar_Call {
+	_c.Call.Maybe()
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) After(d time.Duration) *mockfoo_Bar_Call {
+	_c.Call.After(d)
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) WaitUntil(w <-chan time.Time) *mockfoo_Bar_Call {
+	_c.Call.WaitUntil(w)
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) NotBefore(calls ...*mock.Call) *mockfoo_Bar_Call {
+	_c.Call.NotBefore(calls...)
+	return _c
+}
+
+func (_c *mockfoo_Bar_Call) Unset() *mockfoo_Bar_Call {
+	_c.Call.Unset()
+	return _c
+}
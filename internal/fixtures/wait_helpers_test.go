@@ -0,0 +1,61 @@
+package test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestWaitHelpers(t *testing.T) {
+	m := NewMockRequesterWaitHelpers(t)
+	m.EXPECT().Get("/path").Return("", nil).Once()
+
+	assert.False(t, m.WaitForGet(10*time.Millisecond))
+	go func() {
+		_, _ = m.Get("/path")
+	}()
+	assert.True(t, m.WaitForGet(time.Second))
+}
+
+func TestWaitHelpersNotify(t *testing.T) {
+	var ran bool
+	called := make(chan struct{})
+
+	m := NewMockRequesterWaitHelpers(t)
+	m.EXPECT().Get(mock.Anything).Run(func(path string) {
+		ran = true
+	}).Notify(called).Return("", nil).Once()
+
+	go func() {
+		_, _ = m.Get("/path")
+	}()
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("Get was not called")
+	}
+	assert.True(t, ran)
+}
+
+func TestWaitHelpersExpectations(t *testing.T) {
+	m := NewMockRequesterGenericsWaitHelpers[int, string, int, GetInt, io.Writer, GetGeneric[int], int, int](t)
+	m.EXPECT().GenericArguments(1, "a").Return(2, nil).Once()
+	m.EXPECT().GenericArguments(3, "b").Return(4, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, m.WaitForExpectations(ctx))
+
+	go func() {
+		m.GenericArguments(1, "a")
+		m.GenericArguments(3, "b")
+	}()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.True(t, m.WaitForExpectations(ctx))
+	assert.True(t, m.WaitForGenericArguments(0))
+}
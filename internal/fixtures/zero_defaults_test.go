@@ -0,0 +1,110 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+func TestZeroDefaults(t *testing.T) {
+	m := NewMockRequesterZeroDefaults(t)
+
+	got, err := m.Get("/unexpected")
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+	m.AssertCalled(t, "Get", "/unexpected")
+}
+
+func TestZeroDefaultsExpectationsWin(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	m := NewMockRequesterZeroDefaults(t)
+	m.EXPECT().Get("/expected").Return("found", nil).Once()
+	m.EXPECT().Get(mock.MatchedBy(func(path string) bool {
+		return path == "/missing"
+	})).Return("", errNotFound)
+
+	got, err := m.Get("/expected")
+	assert.NoError(t, err)
+	assert.Equal(t, "found", got)
+	_, err = m.Get("/missing")
+	assert.ErrorIs(t, err, errNotFound)
+	got, err = m.Get("/other")
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+	m.AssertNumberOfCalls(t, "Get", 3)
+}
+
+func TestZeroDefaultsLaterExpectation(t *testing.T) {
+	m := NewMockRequesterZeroDefaults(t)
+
+	got, err := m.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+
+	m.EXPECT().Get("a").Return("x", nil).Once()
+	got, err = m.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "x", got)
+	m.AssertExpectations(t)
+}
+
+func TestZeroDefaultsUsedUpExpectation(t *testing.T) {
+	m := NewMockRequesterZeroDefaults(t)
+	m.EXPECT().Get("a").Return("x", nil).Once()
+
+	got, _ := m.Get("a")
+	assert.Equal(t, "x", got)
+	got, err := m.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "", got)
+	m.AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestZeroDefaultsConcurrent(t *testing.T) {
+	m := NewMockRequesterZeroDefaults(t)
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := m.Get(fmt.Sprintf("/path/%d", i))
+			assert.NoError(t, err)
+			assert.Equal(t, "", got)
+		}()
+	}
+	wg.Wait()
+	m.AssertNumberOfCalls(t, "Get", 20)
+}
+
+func TestZeroDefaultsTypes(t *testing.T) {
+	imports := NewMockImportsSameAsPackageZeroDefaults(t)
+	assert.Zero(t, imports.A())
+	assert.Nil(t, imports.B())
+	imports.C(1)
+	imports.AssertCalled(t, "C", C(1))
+
+	generics := NewMockRequesterGenericsZeroDefaults[int, string, int, GetInt, io.Writer, GetGeneric[int], int, int](t)
+	signed, intf := generics.GenericArguments(1, "a")
+	assert.Zero(t, signed)
+	assert.Nil(t, intf)
+	assert.Zero(t, generics.GenericStructs(GenericType[int, GetInt]{}))
+}
+
+func TestZeroDefaultsVariadic(t *testing.T) {
+	unrolled := NewMockRequesterVariadicZeroDefaults(t)
+	assert.False(t, unrolled.Get("a", "b"))
+	assert.Equal(t, "", unrolled.Sprintf("%s", "a"))
+	unrolled.AssertCalled(t, "Get", "a", "b")
+
+	rolled := NewMockRequesterVariadicOneArgumentZeroDefaults(t)
+	assert.False(t, rolled.Get())
+	assert.False(t, rolled.Get("a"))
+	rolled.AssertCalled(t, "Get", []string{"a"})
+}
@@ -32,10 +32,50 @@ const (
 )
 
 var (
+	//go:embed mock_conformance.templ
+	templateConformance string
+	//go:embed mock_conformance.templ.schema.json
+	templateConformanceJSONSchema string
+	//go:embed mock_counterfeiter.templ
+	templateCounterfeiter string
+	//go:embed mock_counterfeiter.templ.schema.json
+	templateCounterfeiterJSONSchema string
+	//go:embed mock_fuzz.templ
+	templateFuzz string
+	//go:embed mock_fuzz.templ.schema.json
+	templateFuzzJSONSchema string
+	//go:embed mock_gomock.templ
+	templateGomock string
+	//go:embed mock_gomock.templ.schema.json
+	templateGomockJSONSchema string
 	//go:embed mock_matryer.templ
 	templateMatryer string
 	//go:embed mock_matryer.templ.schema.json
 	templateMatryerJSONSchema string
+	//go:embed mock_otel.templ
+	templateOtel string
+	//go:embed mock_otel.templ.schema.json
+	templateOtelJSONSchema string
+	//go:embed mock_recorder.templ
+	templateRecorder string
+	//go:embed mock_recorder.templ.schema.json
+	templateRecorderJSONSchema string
+	//go:embed mock_replayer.templ
+	templateReplayer string
+	//go:embed mock_replayer.templ.schema.json
+	templateReplayerJSONSchema string
+	//go:embed mock_resilient.templ
+	templateResilient string
+	//go:embed mock_resilient.templ.schema.json
+	templateResilientJSONSchema string
+	//go:embed mock_slog.templ
+	templateSlog string
+	//go:embed mock_slog.templ.schema.json
+	templateSlogJSONSchema string
+	//go:embed mock_stub.templ
+	templateStub string
+	//go:embed mock_stub.templ.schema.json
+	templateStubJSONSchema string
 	//go:embed mock_testify.templ
 	templateTestify string
 	//go:embed mock_testify.templ.schema.json
@@ -45,13 +85,33 @@ var (
 var errBadHTTPStatus = errors.New("failed to download file")
 
 var styleTemplates = map[string]string{
-	"matryer": templateMatryer,
-	"testify": templateTestify,
+	"conformance":   templateConformance,
+	"counterfeiter": templateCounterfeiter,
+	"fuzz":          templateFuzz,
+	"gomock":        templateGomock,
+	"matryer":       templateMatryer,
+	"otel":          templateOtel,
+	"recorder":      templateRecorder,
+	"replayer":      templateReplayer,
+	"resilient":     templateResilient,
+	"slog":          templateSlog,
+	"stub":          templateStub,
+	"testify":       templateTestify,
 }
 
 var jsonSchemas = map[string]string{
-	"matryer": templateMatryerJSONSchema,
-	"testify": templateTestifyJSONSchema,
+	"conformance":   templateConformanceJSONSchema,
+	"counterfeiter": templateCounterfeiterJSONSchema,
+	"fuzz":          templateFuzzJSONSchema,
+	"gomock":        templateGomockJSONSchema,
+	"matryer":       templateMatryerJSONSchema,
+	"otel":          templateOtelJSONSchema,
+	"recorder":      templateRecorderJSONSchema,
+	"replayer":      templateReplayerJSONSchema,
+	"resilient":     templateResilientJSONSchema,
+	"slog":          templateSlogJSONSchema,
+	"stub":          templateStubJSONSchema,
+	"testify":       templateTestifyJSONSchema,
 }
 
 // findPkgPath returns the fully-qualified go import path of a given dir. The
@@ -0,0 +1,234 @@
+// Package recording implements the golden file format used by the mocks
+// generated with the `recorder` and `replayer` templates.
+//
+// A recorder wraps a real implementation of an interface and records the
+// arguments and results of every call made to it. The calls are saved as a
+// JSON golden file:
+//
+//	{
+//	  "calls": [
+//	    {
+//	      "method": "Get",
+//	      "args": ["foo"],
+//	      "results": ["bar", null]
+//	    }
+//	  ]
+//	}
+//
+// A replayer implements the same interface by serving the recorded results
+// back, in order, and fails the test when a call doesn't match the recording.
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// File is the content of a golden file.
+type File struct {
+	Calls []Call `json:"calls"`
+}
+
+// Call is a single recorded method call.
+type Call struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// EncodeError returns the representation of err in a golden file: nil for a
+// nil error, its message otherwise.
+func EncodeError(err error) *string {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	return &msg
+}
+
+// DecodeError returns the error recorded by EncodeError. The original type of
+// the error is lost; only its message is preserved.
+func DecodeError(msg *string) error {
+	if msg == nil {
+		return nil
+	}
+	return errors.New(*msg)
+}
+
+func encodeValues(values []any) ([]json.RawMessage, error) {
+	encoded := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = b
+	}
+	return encoded, nil
+}
+
+// Recorder records calls to be saved to a golden file. It is safe for
+// concurrent use.
+type Recorder struct {
+	path string
+
+	mu    sync.Mutex
+	calls []Call
+	err   error
+}
+
+// NewRecorder returns a Recorder that saves its calls to path.
+func NewRecorder(path string) *Recorder {
+	return &Recorder{path: path, calls: []Call{}}
+}
+
+// Record adds a call of method to the recording. Errors in results must be
+// encoded with EncodeError. Values that can't be encoded to JSON cause Save to
+// fail.
+func (r *Recorder) Record(method string, args []any, results []any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	encodedArgs, err := encodeValues(args)
+	if err != nil {
+		r.err = errors.Join(r.err, fmt.Errorf("encoding arguments of call %d to %s: %w", len(r.calls), method, err))
+		return
+	}
+	encodedResults, err := encodeValues(results)
+	if err != nil {
+		r.err = errors.Join(r.err, fmt.Errorf("encoding results of call %d to %s: %w", len(r.calls), method, err))
+		return
+	}
+	r.calls = append(r.calls, Call{Method: method, Args: encodedArgs, Results: encodedResults})
+}
+
+// Save writes the recorded calls to the golden file, creating its directory
+// if needed. It fails if any call couldn't be recorded.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	b, err := json.MarshalIndent(File{Calls: r.calls}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(b, '\n'), 0o644)
+}
+
+// TestingT is the subset of testing.TB used by Replayer.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Cleanup(func())
+}
+
+// Replayer serves the calls of a golden file back in order. It is safe for
+// concurrent use, although concurrent calls must be replayed in the order
+// they were recorded.
+type Replayer struct {
+	t    TestingT
+	path string
+
+	mu    sync.Mutex
+	calls []Call
+	next  int
+	// failed is set once a call didn't match the recording, to not report
+	// the calls left over because of it.
+	failed bool
+}
+
+// NewReplayer loads the golden file at path. The test fails at cleanup if
+// some of the recorded calls weren't replayed.
+func NewReplayer(t TestingT, path string) *Replayer {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+	var file File
+	if err := json.Unmarshal(b, &file); err != nil {
+		t.Fatalf("decoding golden file %s: %v", path, err)
+	}
+	r := &Replayer{t: t, path: path, calls: file.Calls}
+	t.Cleanup(func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if remaining := len(r.calls) - r.next; remaining != 0 && !r.failed {
+			r.t.Errorf("%s: %d recorded calls were not replayed, starting with call %d to %s", r.path, remaining, r.next, r.calls[r.next].Method)
+		}
+	})
+	return r
+}
+
+// Replay checks that the next recorded call is a call of method with args, and
+// decodes its results into the given pointers. Errors must be decoded into a
+// *string and converted with DecodeError. The test fails if the call doesn't
+// match the recording.
+func (r *Replayer) Replay(method string, args []any, results ...any) {
+	r.t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.next == len(r.calls) {
+		r.fatalf("%s: unexpected call to %s, all %d recorded calls were replayed", r.path, method, len(r.calls))
+		return
+	}
+	idx := r.next
+	call := r.calls[idx]
+	r.next++
+	if call.Method != method {
+		r.fatalf("%s: call %d: expected a call to %s, got a call to %s", r.path, idx, call.Method, method)
+		return
+	}
+	encodedArgs, err := encodeValues(args)
+	if err != nil {
+		r.fatalf("%s: call %d to %s: encoding arguments: %v", r.path, idx, method, err)
+		return
+	}
+	if len(encodedArgs) != len(call.Args) {
+		r.fatalf("%s: call %d to %s: expected %d arguments, got %d", r.path, idx, method, len(call.Args), len(encodedArgs))
+		return
+	}
+	for i := range encodedArgs {
+		if !jsonEqual(call.Args[i], encodedArgs[i]) {
+			r.fatalf("%s: call %d to %s: argument %d: expected %s, got %s", r.path, idx, method, i, call.Args[i], encodedArgs[i])
+			return
+		}
+	}
+	if len(results) != len(call.Results) {
+		r.fatalf("%s: call %d to %s: expected %d results, got %d", r.path, idx, method, len(call.Results), len(results))
+		return
+	}
+	for i, result := range results {
+		if err := json.Unmarshal(call.Results[i], result); err != nil {
+			r.fatalf("%s: call %d to %s: decoding result %d: %v", r.path, idx, method, i, err)
+			return
+		}
+	}
+}
+
+// fatalf fails the test. It must be called with r.mu held.
+func (r *Replayer) fatalf(format string, args ...any) {
+	r.t.Helper()
+	r.failed = true
+	r.t.Fatalf(format, args...)
+}
+
+// jsonEqual reports whether a and b are the same JSON document, ignoring
+// insignificant whitespace.
+func jsonEqual(a, b json.RawMessage) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
@@ -0,0 +1,140 @@
+package recording
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeT records the failures reported by a Replayer.
+type fakeT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (f *fakeT) Cleanup(fn func()) {
+	f.cleanups = append(f.cleanups, fn)
+}
+
+// run calls fn in its own goroutine so that Fatalf can stop it, then runs the
+// registered cleanups.
+func (f *fakeT) run(fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	<-done
+	for _, cleanup := range f.cleanups {
+		cleanup()
+	}
+}
+
+func record(t *testing.T, calls func(r *Recorder)) string {
+	path := filepath.Join(t.TempDir(), "testdata", "golden.json")
+	r := NewRecorder(path)
+	calls(r)
+	require.NoError(t, r.Save())
+	return path
+}
+
+func TestRecordReplay(t *testing.T) {
+	path := record(t, func(r *Recorder) {
+		r.Record("Get", []any{"foo", map[string]int{"b": 2, "a": 1}}, []any{"bar", EncodeError(nil)})
+		r.Record("Get", []any{"baz", map[string]int{}}, []any{"", EncodeError(errors.New("not found"))})
+	})
+
+	ft := &fakeT{}
+	ft.run(func() {
+		replayer := NewReplayer(ft, path)
+
+		var s string
+		var errMsg *string
+		replayer.Replay("Get", []any{"foo", map[string]int{"a": 1, "b": 2}}, &s, &errMsg)
+		assert.Equal(t, "bar", s)
+		assert.NoError(t, DecodeError(errMsg))
+
+		replayer.Replay("Get", []any{"baz", map[string]int{}}, &s, &errMsg)
+		assert.Equal(t, "", s)
+		assert.EqualError(t, DecodeError(errMsg), "not found")
+	})
+	assert.Empty(t, ft.errors)
+}
+
+func TestReplayFailures(t *testing.T) {
+	path := record(t, func(r *Recorder) {
+		r.Record("Get", []any{"foo"}, []any{"bar"})
+		r.Record("Put", []any{"foo"}, []any{})
+	})
+
+	tests := []struct {
+		name   string
+		replay func(r *Replayer)
+		want   []string
+	}{
+		{
+			name: "unexpected arguments",
+			replay: func(r *Replayer) {
+				var s string
+				r.Replay("Get", []any{"qux"}, &s)
+			},
+			want: []string{path + `: call 0 to Get: argument 0: expected "foo", got "qux"`},
+		},
+		{
+			name: "unexpected method",
+			replay: func(r *Replayer) {
+				r.Replay("Put", []any{"foo"})
+			},
+			want: []string{path + ": call 0: expected a call to Get, got a call to Put"},
+		},
+		{
+			name: "unexpected call",
+			replay: func(r *Replayer) {
+				var s string
+				r.Replay("Get", []any{"foo"}, &s)
+				r.Replay("Put", []any{"foo"})
+				r.Replay("Put", []any{"foo"})
+			},
+			want: []string{path + ": unexpected call to Put, all 2 recorded calls were replayed"},
+		},
+		{
+			name: "calls not replayed",
+			replay: func(r *Replayer) {
+				var s string
+				r.Replay("Get", []any{"foo"}, &s)
+			},
+			want: []string{path + ": 1 recorded calls were not replayed, starting with call 1 to Put"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ft := &fakeT{}
+			ft.run(func() {
+				tc.replay(NewReplayer(ft, path))
+			})
+			assert.Equal(t, tc.want, ft.errors)
+		})
+	}
+}
+
+func TestRecorderSaveFailsOnUnencodableValues(t *testing.T) {
+	r := NewRecorder(filepath.Join(t.TempDir(), "golden.json"))
+	r.Record("Run", []any{func() {}}, []any{})
+	assert.ErrorContains(t, r.Save(), "encoding arguments of call 0 to Run")
+}
@@ -0,0 +1,179 @@
+// Package resilience implements the retry, timeout and circuit breaking
+// policies applied by the decorators generated with the `resilient` template.
+//
+// A decorator holds one Policy per method that accepts a context.Context and
+// returns an error, and runs every call to the wrapped implementation through
+// Policy.Do.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned, without calling the wrapped implementation, when the
+// circuit breaker of a policy is open.
+var ErrOpen = errors.New("resilience: circuit breaker is open")
+
+// Policy decides how a call is retried, timed out and short-circuited. The
+// zero Policy calls the wrapped implementation exactly once.
+type Policy struct {
+	// MaxAttempts is the maximum number of attempts, including the first
+	// one. Values lower than 1 are treated as 1.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier is the factor applied to the delay after every retry.
+	// Values lower than 1 are treated as 2.
+	Multiplier float64
+	// Timeout bounds the duration of every attempt. Zero means no timeout.
+	Timeout time.Duration
+	// Breaker, if set, stops calls after consecutive failures.
+	Breaker *Breaker
+}
+
+// Do calls fn until it succeeds, returns a permanent error, or the policy
+// runs out of attempts, and returns the last error. The context passed to fn
+// is bounded by the policy's Timeout. Do stops retrying once ctx is done.
+func (p Policy) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	attempts := max(p.MaxAttempts, 1)
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	backoff := p.InitialBackoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if p.Breaker != nil && !p.Breaker.Allow() {
+			if err == nil {
+				err = ErrOpen
+			}
+			return err
+		}
+		err = p.attempt(ctx, fn)
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			if p.Breaker != nil {
+				p.Breaker.Record(nil)
+			}
+			return permanent.err
+		}
+		if p.Breaker != nil {
+			p.Breaker.Record(err)
+		}
+		if err == nil || attempt >= attempts || ctx.Err() != nil {
+			return err
+		}
+		if !sleep(ctx, backoff) {
+			return err
+		}
+		backoff = time.Duration(float64(backoff) * multiplier)
+		if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
+
+func (p Policy) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if p.Timeout <= 0 {
+		return fn(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// sleep waits for d, and returns false if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as not worth retrying. Policy.Do returns err, unwrapped,
+// as soon as it is returned, and doesn't count it as a failure of the circuit
+// breaker: the wrapped implementation answered, it just answered with an
+// error. Permanent returns nil if err is nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Breaker is a circuit breaker. It opens after FailureThreshold consecutive
+// failures, rejects calls for Cooldown, then lets a single trial call through:
+// the breaker closes if it succeeds and opens again if it fails.
+type Breaker struct {
+	failureThreshold int
+	cooldown         time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// NewBreaker returns a closed Breaker.
+func NewBreaker(failureThreshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		failureThreshold: max(failureThreshold, 1),
+		cooldown:         cooldown,
+		now:              time.Now,
+	}
+}
+
+// Allow reports whether a call may proceed.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.failureThreshold {
+		return true
+	}
+	if b.trial || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+// Record reports the outcome of a call allowed by Allow.
+func (b *Breaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+	if err == nil {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.failureThreshold {
+		b.openedAt = b.now()
+	}
+}
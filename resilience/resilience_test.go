@@ -0,0 +1,110 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errBoom = errors.New("boom")
+
+// failing returns a function that fails n times, then succeeds, along with a
+// pointer to the number of times it was called.
+func failing(n int) (func(ctx context.Context) error, *int) {
+	calls := 0
+	return func(ctx context.Context) error {
+		calls++
+		if calls <= n {
+			return errBoom
+		}
+		return nil
+	}, &calls
+}
+
+func TestPolicyZeroValueCallsOnce(t *testing.T) {
+	fn, calls := failing(1)
+	err := Policy{}.Do(context.Background(), fn)
+	assert.ErrorIs(t, err, errBoom)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestPolicyRetries(t *testing.T) {
+	fn, calls := failing(2)
+	err := Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond}.Do(context.Background(), fn)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *calls)
+
+	fn, calls = failing(5)
+	err = Policy{MaxAttempts: 3}.Do(context.Background(), fn)
+	assert.ErrorIs(t, err, errBoom)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestPolicyPermanentError(t *testing.T) {
+	calls := 0
+	err := Policy{MaxAttempts: 3}.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		return Permanent(errBoom)
+	})
+	assert.Equal(t, errBoom, err)
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, Permanent(nil))
+}
+
+func TestPolicyTimeout(t *testing.T) {
+	calls := 0
+	err := Policy{MaxAttempts: 2, Timeout: time.Millisecond}.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPolicyStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := Policy{MaxAttempts: 5, InitialBackoff: time.Hour}.Do(ctx, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return errBoom
+	})
+	assert.ErrorIs(t, err, errBoom)
+	assert.Equal(t, 1, calls)
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	breaker := NewBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+	policy := Policy{Breaker: breaker}
+
+	fn, calls := failing(3)
+	assert.ErrorIs(t, policy.Do(context.Background(), fn), errBoom)
+	assert.ErrorIs(t, policy.Do(context.Background(), fn), errBoom)
+	assert.ErrorIs(t, policy.Do(context.Background(), fn), ErrOpen)
+	assert.Equal(t, 2, *calls)
+
+	// After the cooldown, a single failing trial call opens the breaker again.
+	now = now.Add(time.Minute)
+	assert.ErrorIs(t, policy.Do(context.Background(), fn), errBoom)
+	assert.ErrorIs(t, policy.Do(context.Background(), fn), ErrOpen)
+	assert.Equal(t, 3, *calls)
+
+	// A successful trial call closes it.
+	now = now.Add(time.Minute)
+	assert.NoError(t, policy.Do(context.Background(), fn))
+	assert.NoError(t, policy.Do(context.Background(), fn))
+	assert.Equal(t, 5, *calls)
+}
+
+func TestBreakerStopsRetries(t *testing.T) {
+	fn, calls := failing(5)
+	policy := Policy{MaxAttempts: 5, Breaker: NewBreaker(2, time.Minute)}
+	assert.ErrorIs(t, policy.Do(context.Background(), fn), errBoom)
+	assert.Equal(t, 2, *calls)
+}
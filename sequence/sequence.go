@@ -0,0 +1,160 @@
+// Package sequence implements the ordering helpers used with testify mocks
+// generated with `in-order: true`.
+//
+// InOrder makes expectations, possibly of different mocks, wait for each
+// other, so that a call made out of order fails the test:
+//
+//	begin := db.EXPECT().Begin().Return(tx, nil)
+//	exec := tx.EXPECT().Exec(mock.Anything).Return(nil)
+//	commit := tx.EXPECT().Commit().Return(nil)
+//	sequence.InOrder(t, begin, exec, commit)
+//
+// A Sequence shared between several mocks records their calls in the order
+// they were made, and can be checked once the code under test returns:
+//
+//	var seq sequence.Sequence
+//	db.InSequence(&seq)
+//	tx.InSequence(&seq)
+//	...
+//	seq.AssertOrder(t, begin, exec, commit)
+//
+// These helpers are defined in this package, instead of in every generated
+// file, so that mocks generated into several files and packages can share
+// them.
+package sequence
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Expectation is implemented by the typed calls returned by the expecter
+// methods of the generated mocks.
+type Expectation interface {
+	MockCall() *mock.Call
+}
+
+type tHelper interface {
+	Helper()
+}
+
+// InOrder makes each expectation wait for the previous one, like
+// mock.Call.NotBefore: a call matching one of them fails the test unless the
+// previous expectations have been met.
+func InOrder(t mock.TestingT, calls ...Expectation) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	for i, call := range calls {
+		if call == nil || call.MockCall() == nil || call.MockCall().Parent == nil {
+			t.Errorf("sequence: expectation #%d wasn't created by an expecter", i)
+			return
+		}
+	}
+	for i := 1; i < len(calls); i++ {
+		calls[i].MockCall().NotBefore(calls[i-1].MockCall())
+	}
+}
+
+// Call is a call recorded by a Sequence.
+type Call struct {
+	// Mock is the mock the call was made to.
+	Mock *mock.Mock
+	// Method is the name of the method called.
+	Method string
+	// Arguments are the arguments of the call, as passed to mock.Called.
+	Arguments mock.Arguments
+}
+
+func (c Call) String() string {
+	return format(c.Method, c.Arguments)
+}
+
+// Sequence records, in order, the calls made to the mocks sharing it. The
+// zero Sequence is ready to use. A nil *Sequence ignores calls.
+type Sequence struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record records a call to method of m.
+func (s *Sequence) Record(m *mock.Mock, method string, args ...interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, Call{Mock: m, Method: method, Arguments: args})
+}
+
+// Calls returns the recorded calls, in order.
+func (s *Sequence) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// AssertOrder asserts that calls matching the expectations were recorded in
+// the given order. Other calls may have been recorded in between.
+func (s *Sequence) AssertOrder(t mock.TestingT, expected ...Expectation) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	calls := s.Calls()
+	next := 0
+	for i, e := range expected {
+		want := e.MockCall()
+		found := false
+		for ; next < len(calls); next++ {
+			if matches(want, calls[next]) {
+				found = true
+				next++
+				break
+			}
+		}
+		if !found {
+			t.Errorf("sequence: expected %s (#%d) to be called after %s\n\nrecorded calls:\n%s",
+				format(want.Method, want.Arguments), i, describePrevious(expected[:i]), describe(calls))
+			return false
+		}
+	}
+	return true
+}
+
+func matches(want *mock.Call, got Call) bool {
+	if want.Parent != got.Mock || want.Method != got.Method {
+		return false
+	}
+	_, diffCount := want.Arguments.Diff(got.Arguments)
+	return diffCount == 0
+}
+
+func describePrevious(previous []Expectation) string {
+	if len(previous) == 0 {
+		return "the start of the sequence"
+	}
+	last := previous[len(previous)-1].MockCall()
+	return format(last.Method, last.Arguments)
+}
+
+func describe(calls []Call) string {
+	if len(calls) == 0 {
+		return "\t(none)"
+	}
+	lines := make([]string, len(calls))
+	for i, call := range calls {
+		lines[i] = fmt.Sprintf("\t%d: %s", i, call)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func format(method string, args mock.Arguments) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = fmt.Sprintf("%#v", arg)
+	}
+	return method + "(" + strings.Join(parts, ", ") + ")"
+}
@@ -0,0 +1,96 @@
+package sequence
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type expectation struct {
+	*mock.Call
+}
+
+func (e expectation) MockCall() *mock.Call {
+	return e.Call
+}
+
+type recordingT struct {
+	errors []string
+}
+
+func (t *recordingT) Logf(format string, args ...interface{}) {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingT) FailNow() {}
+
+func TestAssertOrder(t *testing.T) {
+	var (
+		db, tx mock.Mock
+		seq    Sequence
+	)
+	begin := expectation{db.On("Begin")}
+	exec := expectation{tx.On("Exec", mock.Anything)}
+	commit := expectation{tx.On("Commit")}
+
+	seq.Record(&db, "Begin")
+	seq.Record(&tx, "Exec", "INSERT")
+	seq.Record(&db, "Ping")
+	seq.Record(&tx, "Commit")
+
+	assert.True(t, seq.AssertOrder(t, begin, exec, commit))
+	assert.True(t, seq.AssertOrder(t, begin, commit))
+	assert.Len(t, seq.Calls(), 4)
+
+	var rt recordingT
+	assert.False(t, seq.AssertOrder(&rt, commit, exec))
+	if assert.Len(t, rt.errors, 1) {
+		assert.Contains(t, rt.errors[0], `expected Exec("mock.Anything") (#1) to be called after Commit()`)
+		assert.Contains(t, rt.errors[0], `1: Exec("INSERT")`)
+	}
+}
+
+func TestAssertOrderMocks(t *testing.T) {
+	var (
+		a, b mock.Mock
+		seq  Sequence
+	)
+	get := expectation{a.On("Get")}
+
+	seq.Record(&b, "Get")
+	var rt recordingT
+	assert.False(t, seq.AssertOrder(&rt, get))
+}
+
+func TestNilSequence(t *testing.T) {
+	var (
+		m   mock.Mock
+		seq *Sequence
+	)
+	assert.NotPanics(t, func() {
+		seq.Record(&m, "Get")
+	})
+}
+
+func TestInOrder(t *testing.T) {
+	m := new(mock.Mock)
+	first := expectation{m.On("First").Return()}
+	second := expectation{m.On("Second").Return()}
+	InOrder(t, first, second)
+
+	assert.Panics(t, func() {
+		m.MethodCalled("Second")
+	})
+	m.MethodCalled("First")
+	m.MethodCalled("Second")
+}
+
+func TestInOrderInvalid(t *testing.T) {
+	var rt recordingT
+	InOrder(&rt, expectation{&mock.Call{}})
+	assert.Len(t, rt.errors, 1)
+}
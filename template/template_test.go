@@ -133,3 +133,153 @@ func TestTemplateMockFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestVarZeroValue(t *testing.T) {
+	pkg := types.NewPackage("github.com/some/module", "module")
+	named := func(name string, underlying types.Type) types.Type {
+		return types.NewNamed(types.NewTypeName(0, pkg, name, nil), underlying, nil)
+	}
+	typeParam := types.NewTypeParam(types.NewTypeName(0, pkg, "T", nil), types.NewInterfaceType(nil, nil))
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{name: "bool", typ: types.Typ[types.Bool], want: "false"},
+		{name: "int", typ: types.Typ[types.Int], want: "0"},
+		{name: "float", typ: types.Typ[types.Float64], want: "0"},
+		{name: "complex", typ: types.Typ[types.Complex128], want: "0"},
+		{name: "string", typ: types.Typ[types.String], want: `""`},
+		{name: "unsafe pointer", typ: types.Typ[types.UnsafePointer], want: "nil"},
+		{name: "error", typ: types.Universe.Lookup("error").Type(), want: "nil"},
+		{name: "pointer", typ: types.NewPointer(types.Typ[types.Int]), want: "nil"},
+		{name: "slice", typ: types.NewSlice(types.Typ[types.Int]), want: "nil"},
+		{name: "map", typ: types.NewMap(types.Typ[types.String], types.Typ[types.Int]), want: "nil"},
+		{name: "chan", typ: types.NewChan(types.SendRecv, types.Typ[types.Int]), want: "nil"},
+		{name: "func", typ: types.NewSignatureType(nil, nil, nil, nil, nil, false), want: "nil"},
+		{name: "array", typ: types.NewArray(types.Typ[types.Int], 3), want: "[3]int{}"},
+		{name: "anonymous struct", typ: types.NewStruct(nil, nil), want: "struct{}{}"},
+		{name: "named int", typ: named("Status", types.Typ[types.Int]), want: "0"},
+		{name: "named struct", typ: named("Config", types.NewStruct(nil, nil)), want: "Config{}"},
+		{name: "named interface", typ: named("Getter", types.NewInterfaceType(nil, nil)), want: "nil"},
+		{name: "type param", typ: typeParam, want: "*new(T)"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Var{typ: tc.typ, pkgPath: pkg.Path()}
+			assert.Equal(t, tc.want, v.ZeroValue())
+		})
+	}
+}
+
+func TestVarBasicName(t *testing.T) {
+	pkg := types.NewPackage("github.com/some/module", "module")
+	named := func(name string, underlying types.Type) types.Type {
+		return types.NewNamed(types.NewTypeName(0, pkg, name, nil), underlying, nil)
+	}
+	typeParam := types.NewTypeParam(types.NewTypeName(0, pkg, "T", nil), types.NewInterfaceType(nil, nil))
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{name: "int", typ: types.Typ[types.Int], want: "int"},
+		{name: "byte", typ: types.Universe.Lookup("byte").Type(), want: "uint8"},
+		{name: "rune", typ: types.Universe.Lookup("rune").Type(), want: "int32"},
+		{name: "string", typ: types.Typ[types.String], want: "string"},
+		{name: "named bool", typ: named("Flag", types.Typ[types.Bool]), want: "bool"},
+		{name: "unsafe pointer", typ: types.Typ[types.UnsafePointer], want: ""},
+		{name: "error", typ: types.Universe.Lookup("error").Type(), want: ""},
+		{name: "slice", typ: types.NewSlice(types.Typ[types.Int]), want: ""},
+		{name: "type param", typ: typeParam, want: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Var{typ: tc.typ, pkgPath: pkg.Path()}
+			assert.Equal(t, tc.want, v.BasicName())
+		})
+	}
+}
+
+func TestVarIsNonEmptyInterface(t *testing.T) {
+	pkg := types.NewPackage("github.com/some/module", "module")
+	errorType := types.Universe.Lookup("error").Type()
+	reader := types.NewNamed(types.NewTypeName(0, pkg, "Reader", nil), nil, nil)
+	reader.SetUnderlying(types.NewInterfaceType([]*types.Func{
+		types.NewFunc(0, pkg, "Read", types.NewSignatureType(nil, nil, nil, nil, nil, false)),
+	}, nil).Complete())
+	typeParam := types.NewTypeParam(types.NewTypeName(0, pkg, "T", nil), reader)
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want bool
+	}{
+		{name: "error", typ: errorType, want: true},
+		{name: "named interface", typ: reader, want: true},
+		{name: "empty interface", typ: types.NewInterfaceType(nil, nil), want: false},
+		{name: "any", typ: types.Universe.Lookup("any").Type(), want: false},
+		{name: "pointer", typ: types.NewPointer(reader), want: false},
+		{name: "type param", typ: typeParam, want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Var{typ: tc.typ, pkgPath: pkg.Path()}
+			assert.Equal(t, tc.want, v.IsNonEmptyInterface())
+		})
+	}
+}
+
+func TestVarSampleValue(t *testing.T) {
+	pkg := types.NewPackage("github.com/some/module", "module")
+	named := func(name string, underlying types.Type) types.Type {
+		return types.NewNamed(types.NewTypeName(0, pkg, name, nil), underlying, nil)
+	}
+	contextPkg := types.NewPackage("context", "context")
+	contextType := types.NewNamed(types.NewTypeName(0, contextPkg, "Context", nil), types.NewInterfaceType(nil, nil), nil)
+	typeParam := types.NewTypeParam(types.NewTypeName(0, pkg, "T", nil), types.NewInterfaceType(nil, nil))
+	recursive := func(name string, underlying func(types.Type) types.Type) types.Type {
+		t := types.NewNamed(types.NewTypeName(0, pkg, name, nil), nil, nil)
+		t.SetUnderlying(underlying(t))
+		return t
+	}
+	recursiveSlice := recursive("L", func(t types.Type) types.Type { return types.NewSlice(t) })
+	recursiveMap := recursive("M", func(t types.Type) types.Type { return types.NewMap(types.Typ[types.String], t) })
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{name: "bool", typ: types.Typ[types.Bool], want: "true"},
+		{name: "int", typ: types.Typ[types.Int], want: "1"},
+		{name: "float", typ: types.Typ[types.Float64], want: "1"},
+		{name: "string", typ: types.Typ[types.String], want: `"sample"`},
+		{name: "unsafe pointer", typ: types.Typ[types.UnsafePointer], want: "nil"},
+		{name: "context", typ: contextType, want: "context.Background()"},
+		{name: "error", typ: types.Universe.Lookup("error").Type(), want: "nil"},
+		{name: "pointer", typ: types.NewPointer(named("Config", types.NewStruct(nil, nil))), want: "new(Config)"},
+		{name: "slice", typ: types.NewSlice(types.Typ[types.String]), want: `[]string{"sample"}`},
+		{name: "map", typ: types.NewMap(types.Typ[types.String], types.Typ[types.Int]), want: `map[string]int{"sample": 1}`},
+		{name: "chan", typ: types.NewChan(types.RecvOnly, types.Typ[types.Int]), want: "make(<-chan int, 1)"},
+		{name: "func", typ: types.NewSignatureType(nil, nil, nil, nil, nil, false), want: "nil"},
+		{name: "named string", typ: named("Key", types.Typ[types.String]), want: `"sample"`},
+		{name: "named struct", typ: named("Config", types.NewStruct(nil, nil)), want: "Config{}"},
+		{name: "type param", typ: typeParam, want: "*new(T)"},
+		{name: "recursive slice", typ: recursiveSlice, want: "L{nil}"},
+		{name: "recursive map", typ: recursiveMap, want: `M{"sample": nil}`},
+		{name: "slice of recursive slice", typ: types.NewSlice(recursiveSlice), want: "[]L{L{nil}}"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Var{
+				typ:     tc.typ,
+				imports: map[string]*Package{"context": NewPackage(contextPkg)},
+				pkgPath: pkg.Path(),
+			}
+			assert.Equal(t, tc.want, v.SampleValue())
+		})
+	}
+}
@@ -60,6 +60,109 @@ func (v Var) Nillable() bool {
 	return nillable(v.Type())
 }
 
+// IsNonEmptyInterface reports whether the variable type is an interface type
+// with methods, ex: 'error', 'io.Reader'. Values of such types can't be built
+// without knowing their dynamic type. Type parameters aren't reported.
+func (v Var) IsNonEmptyInterface() bool {
+	if _, ok := types.Unalias(v.Type()).(*types.TypeParam); ok {
+		return false
+	}
+	iface, ok := v.Type().Underlying().(*types.Interface)
+	return ok && iface.NumMethods() > 0
+}
+
+// ZeroValue returns an expression that evaluates to the zero value of the
+// variable type, ex: '0', '""', 'nil', 'pkg.Struct{}'.
+func (v Var) ZeroValue() string {
+	typ := types.Unalias(v.Type())
+	if _, ok := typ.(*types.TypeParam); ok {
+		return "*new(" + v.TypeString() + ")"
+	}
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return "false"
+		case t.Info()&types.IsNumeric != 0:
+			return "0"
+		case t.Info()&types.IsString != 0:
+			return `""`
+		}
+		// unsafe.Pointer
+		return "nil"
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return "nil"
+	case *types.Struct, *types.Array:
+		return v.TypeString() + "{}"
+	}
+	return "*new(" + v.TypeString() + ")"
+}
+
+// BasicName returns the name of the basic type underlying the variable type,
+// ex: 'int', 'string', 'bool'. Aliases are resolved, so 'byte' gives 'uint8'.
+// It returns an empty string if the underlying type isn't basic, or is
+// unsafe.Pointer.
+func (v Var) BasicName() string {
+	if _, ok := types.Unalias(v.Type()).(*types.TypeParam); ok {
+		return ""
+	}
+	t, ok := v.Type().Underlying().(*types.Basic)
+	if !ok || t.Kind() == types.UnsafePointer || t.Info()&types.IsUntyped != 0 {
+		return ""
+	}
+	return types.Typ[t.Kind()].Name()
+}
+
+// SampleValue returns an expression that evaluates to a usable, non-zero value
+// of the variable type where one is easy to build, ex: '1', '"sample"',
+// 'context.Background()', '[]string{"sample"}', 'new(pkg.Struct)'. It falls
+// back to ZeroValue for functions, interfaces and type parameters, and for
+// recursive types such as 'type L []L' once they have been expanded once.
+func (v Var) SampleValue() string {
+	return v.sampleValue(map[*types.Named]bool{})
+}
+
+func (v Var) sampleValue(visited map[*types.Named]bool) string {
+	typ := types.Unalias(v.Type())
+	if named, ok := typ.(*types.Named); ok {
+		if visited[named] {
+			return v.ZeroValue()
+		}
+		visited[named] = true
+		defer delete(visited, named)
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context" {
+			return v.packageQualifier(obj.Pkg()) + ".Background()"
+		}
+	}
+	if _, ok := typ.(*types.TypeParam); ok {
+		return v.ZeroValue()
+	}
+	elem := func(t types.Type) Var {
+		return Var{typ: t, imports: v.imports, pkgPath: v.pkgPath}
+	}
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return "true"
+		case t.Info()&types.IsNumeric != 0:
+			return "1"
+		case t.Info()&types.IsString != 0:
+			return `"sample"`
+		}
+	case *types.Pointer:
+		return "new(" + elem(t.Elem()).TypeString() + ")"
+	case *types.Slice:
+		return v.TypeString() + "{" + elem(t.Elem()).sampleValue(visited) + "}"
+	case *types.Map:
+		return v.TypeString() + "{" + elem(t.Key()).sampleValue(visited) + ": " + elem(t.Elem()).sampleValue(visited) + "}"
+	case *types.Chan:
+		return "make(" + v.TypeString() + ", 1)"
+	}
+	return v.ZeroValue()
+}
+
 func varName(vr *types.Var, suffix string) string {
 	name := vr.Name()
 	if name != "" && name != "_" {
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/huandu/xstrings"
 )
@@ -67,6 +68,9 @@ var FuncMap = template.FuncMap{
 	"expandEnv": os.ExpandEnv,
 	"getenv":    os.Getenv,
 
+	// Stopping the execution with an error
+	"fail": Fail,
+
 	/*******
 	* MATH *
 	********/
@@ -87,4 +91,7 @@ var FuncMap = template.FuncMap{
 
 	// rand
 	"randInt": rand.Int,
+
+	// time
+	"parseDuration": time.ParseDuration,
 }
@@ -280,6 +280,12 @@ func TestTemplateStringFuncs(t *testing.T) {
 			template:  "{{randInt}}",
 			wantRegex: "%d",
 		},
+		{
+			name:     "parseDuration",
+			template: "{{(parseDuration .TemplateData.d).Milliseconds}}",
+			data:     map[string]any{"d": "1m30s"},
+			want:     "90000",
+		},
 	}
 
 	for _, tc := range tests {
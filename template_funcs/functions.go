@@ -2,6 +2,7 @@ package template_funcs
 
 import (
 	"cmp"
+	"errors"
 	"os"
 	"slices"
 	"strings"
@@ -33,6 +34,12 @@ func ReadFile(path string) (string, error) {
 	return string(fileBytes), nil
 }
 
+// Fail returns an error with the given message, which stops the execution of
+// the template.
+func Fail(msg string) (string, error) {
+	return "", errors.New(msg)
+}
+
 // Numbers defines the generic constraints of the arithmetic arguments.
 type Numbers interface {
 	constraints.Integer | constraints.Float | constraints.Complex
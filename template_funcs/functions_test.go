@@ -1,6 +1,19 @@
 package template_funcs
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestFail(t *testing.T) {
+	tmpl := template.Must(template.New("fail").Funcs(FuncMap).Parse(`before{{ fail "unsupported" }}after`))
+	var sb strings.Builder
+	err := tmpl.Execute(&sb, nil)
+	if err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("Execute() error = %v, want an error containing %q", err, "unsupported")
+	}
+}
 
 func TestFirstIsLower(t *testing.T) {
 	tests := []struct {